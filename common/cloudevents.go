@@ -0,0 +1,367 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CloudEvents 1.0 constants
+const (
+	// MediaTypeCloudEventsJSON is the content type of CloudEvents 1.0 events in structured mode
+	MediaTypeCloudEventsJSON = "application/cloudevents+json"
+	// HeaderCloudEventsPrefix is the prefix of the HTTP headers carrying the attributes of CloudEvents 1.0 events in binary mode
+	HeaderCloudEventsPrefix = "Ce-"
+	// CloudEventsExtensionEventTypeVersion is the extension carrying the event type version of the context of an event
+	CloudEventsExtensionEventTypeVersion = "eventtypeversion"
+)
+
+// attributes of CloudEvents 1.0 events
+const (
+	ceSpecVersion     = "specversion"
+	ceID              = "id"
+	ceSource          = "source"
+	ceType            = "type"
+	ceTime            = "time"
+	ceDataContentType = "datacontenttype"
+	ceDataSchema      = "dataschema"
+	ceData            = "data"
+	ceDataBase64      = "data_base64"
+)
+
+// EncodeCloudEvent encodes the event for dispatch over HTTP, returning the headers and the body of the request.
+// Events of CloudEvents 0.1 are encoded as the JSON envelope, events of CloudEvents 1.0 in the binary or structured content mode.
+func EncodeCloudEvent(event *apicommon.Event, version apicommon.CloudEventsSpecVersion, mode apicommon.CloudEventsMode) (http.Header, []byte, error) {
+	header := http.Header{}
+	switch version {
+	case "", apicommon.CloudEventsV01:
+		body, err := json.Marshal(event)
+		if err != nil {
+			return nil, nil, err
+		}
+		header.Set("Content-Type", "application/json")
+		return header, body, nil
+
+	case apicommon.CloudEventsV1:
+		attributes, err := cloudEventAttributes(event)
+		if err != nil {
+			return nil, nil, err
+		}
+		if mode == apicommon.Structured {
+			structured := make(map[string]interface{}, len(attributes)+1)
+			for name, value := range attributes {
+				structured[name] = value
+			}
+			if len(event.Payload) > 0 {
+				switch {
+				case isJSONContentType(event.Context.ContentType) && json.Valid(event.Payload):
+					structured[ceData] = json.RawMessage(event.Payload)
+				case isTextContentType(event.Context.ContentType):
+					structured[ceData] = string(event.Payload)
+				default:
+					structured[ceDataBase64] = base64.StdEncoding.EncodeToString(event.Payload)
+				}
+			}
+			body, err := json.Marshal(structured)
+			if err != nil {
+				return nil, nil, err
+			}
+			header.Set("Content-Type", MediaTypeCloudEventsJSON)
+			return header, body, nil
+		}
+		for name, value := range attributes {
+			if name == ceDataContentType {
+				header.Set("Content-Type", value)
+				continue
+			}
+			header.Set(HeaderCloudEventsPrefix+name, encodeHeaderValue(value))
+		}
+		return header, event.Payload, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unknown cloudevents version %s", version)
+	}
+}
+
+// DecodeCloudEvent decodes an event received over HTTP or NATS, in any of the supported formats: CloudEvents 1.0 in binary
+// or structured mode, or the JSON envelope of CloudEvents 0.1. The header is nil for events received over NATS.
+func DecodeCloudEvent(header http.Header, body []byte) (*apicommon.Event, error) {
+	if header != nil && header.Get(HeaderCloudEventsPrefix+ceSpecVersion) != "" {
+		return decodeBinaryCloudEvent(header, body)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+	if _, ok := fields[ceSpecVersion]; ok {
+		return decodeStructuredCloudEvent(fields)
+	}
+
+	var event *apicommon.Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// cloudEventAttributes returns the CloudEvents 1.0 attributes of the event, including its extensions.
+// Extensions whose names are reduced to the same attribute name are rejected, as one would silently override the other.
+func cloudEventAttributes(event *apicommon.Event) (map[string]string, error) {
+	attributes := make(map[string]string)
+	extensions := make(map[string]string)
+	// extensions are set first so they can't override the attributes of the event
+	for name, value := range event.Context.Extensions {
+		attribute := ExtensionName(name)
+		if attribute == "" {
+			continue
+		}
+		if other, ok := extensions[attribute]; ok {
+			return nil, fmt.Errorf("extensions %s and %s are both carried as attribute %s", other, name, attribute)
+		}
+		extensions[attribute] = name
+		attributes[attribute] = value
+	}
+	if event.Context.EventTypeVersion != "" {
+		attributes[CloudEventsExtensionEventTypeVersion] = event.Context.EventTypeVersion
+	}
+	attributes[ceSpecVersion] = string(apicommon.CloudEventsV1)
+	attributes[ceID] = event.Context.EventID
+	attributes[ceType] = event.Context.EventType
+	if event.Context.Source != nil {
+		attributes[ceSource] = event.Context.Source.Host
+	}
+	if !event.Context.EventTime.IsZero() {
+		attributes[ceTime] = event.Context.EventTime.UTC().Format(time.RFC3339Nano)
+	}
+	if event.Context.ContentType != "" {
+		attributes[ceDataContentType] = event.Context.ContentType
+	}
+	if event.Context.SchemaURL != nil {
+		attributes[ceDataSchema] = uriString(event.Context.SchemaURL)
+	}
+	return attributes, nil
+}
+
+// decodeBinaryCloudEvent decodes a CloudEvents 1.0 event in binary mode
+func decodeBinaryCloudEvent(header http.Header, body []byte) (*apicommon.Event, error) {
+	attributes := map[string]string{
+		ceDataContentType: header.Get("Content-Type"),
+	}
+	for name, values := range header {
+		if len(values) == 0 || !strings.HasPrefix(name, HeaderCloudEventsPrefix) {
+			continue
+		}
+		value, err := url.PathUnescape(values[0])
+		if err != nil {
+			value = values[0]
+		}
+		attributes[strings.ToLower(strings.TrimPrefix(name, HeaderCloudEventsPrefix))] = value
+	}
+	return newCloudEvent(attributes, body)
+}
+
+// decodeStructuredCloudEvent decodes a CloudEvents 1.0 event in structured mode
+func decodeStructuredCloudEvent(fields map[string]json.RawMessage) (*apicommon.Event, error) {
+	attributes := make(map[string]string)
+	for name, raw := range fields {
+		if name == ceData || name == ceDataBase64 {
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			// extensions may be numbers or booleans
+			value = string(raw)
+		}
+		attributes[name] = value
+	}
+
+	var payload []byte
+	if raw, ok := fields[ceDataBase64]; ok {
+		var encoded string
+		if err := json.Unmarshal(raw, &encoded); err != nil {
+			return nil, fmt.Errorf("failed to parse data_base64. err: %+v", err)
+		}
+		data, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode data_base64. err: %+v", err)
+		}
+		payload = data
+	} else if raw, ok := fields[ceData]; ok {
+		payload = raw
+		// a string is the data itself unless the data is JSON
+		var text string
+		if !isJSONContentType(attributes[ceDataContentType]) && json.Unmarshal(raw, &text) == nil {
+			payload = []byte(text)
+		}
+	}
+	return newCloudEvent(attributes, payload)
+}
+
+// newCloudEvent returns the event of the CloudEvents 1.0 attributes and data
+func newCloudEvent(attributes map[string]string, payload []byte) (*apicommon.Event, error) {
+	if version := attributes[ceSpecVersion]; version != string(apicommon.CloudEventsV1) {
+		return nil, fmt.Errorf("unsupported cloudevents version %s", version)
+	}
+	for _, required := range []string{ceID, ceSource, ceType} {
+		if attributes[required] == "" {
+			return nil, fmt.Errorf("cloudevents attribute %s is missing", required)
+		}
+	}
+
+	event := &apicommon.Event{
+		Context: apicommon.EventContext{
+			CloudEventsVersion: attributes[ceSpecVersion],
+			EventID:            attributes[ceID],
+			EventType:          attributes[ceType],
+			EventTypeVersion:   attributes[CloudEventsExtensionEventTypeVersion],
+			ContentType:        attributes[ceDataContentType],
+			Source: &apicommon.URI{
+				Host: attributes[ceSource],
+			},
+		},
+		Payload: payload,
+	}
+	if value := attributes[ceTime]; value != "" {
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse cloudevents time. err: %+v", err)
+		}
+		event.Context.EventTime = metav1.MicroTime{Time: t.UTC()}
+	}
+	if value := attributes[ceDataSchema]; value != "" {
+		schema, err := parseURI(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse cloudevents dataschema. err: %+v", err)
+		}
+		event.Context.SchemaURL = schema
+	}
+
+	for name, value := range attributes {
+		switch name {
+		case ceSpecVersion, ceID, ceSource, ceType, ceTime, ceDataContentType, ceDataSchema, CloudEventsExtensionEventTypeVersion:
+			continue
+		}
+		// the other attributes, like the subject, have no counterpart in the context of the event
+		if event.Context.Extensions == nil {
+			event.Context.Extensions = make(map[string]string)
+		}
+		event.Context.Extensions[name] = value
+	}
+	return event, nil
+}
+
+// ExtensionName returns the name of the extension as a CloudEvents 1.0 attribute name, made of lower case letters and digits.
+// Gateways name the extensions of the events they dispatch this way, so the names are the same whichever version of CloudEvents
+// the events are dispatched in.
+func ExtensionName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// isJSONContentType returns true if the content type is JSON. Data without a content type is JSON.
+func isJSONContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// isTextContentType returns true if the content type is text
+func isTextContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(mediaType, "text/") || mediaType == "application/xml" || strings.HasSuffix(mediaType, "+xml")
+}
+
+// encodeHeaderValue percent-encodes the characters that can't be carried in a header value, as required by the HTTP binding of CloudEvents 1.0
+func encodeHeaderValue(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c <= ' ' || c > '~' || c == '"' || c == '%' {
+			fmt.Fprintf(&b, "%%%02X", c)
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// uriString returns the string form of the URI. The user info is left out, so credentials are never passed in attributes.
+func uriString(uri *apicommon.URI) string {
+	u := url.URL{
+		Scheme:   uri.Scheme,
+		Host:     uri.Host,
+		Path:     uri.Path,
+		RawQuery: uri.Query,
+		Fragment: uri.Fragment,
+	}
+	if uri.Port != 0 {
+		u.Host = fmt.Sprintf("%s:%d", uri.Host, uri.Port)
+	}
+	return u.String()
+}
+
+// parseURI parses the URI
+func parseURI(value string) (*apicommon.URI, error) {
+	u, err := url.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	uri := &apicommon.URI{
+		Scheme:   u.Scheme,
+		Host:     u.Hostname(),
+		Path:     u.Path,
+		Query:    u.RawQuery,
+		Fragment: u.Fragment,
+	}
+	if port := u.Port(); port != "" {
+		p, err := strconv.ParseInt(port, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		uri.Port = int32(p)
+	}
+	if u.User != nil {
+		uri.User = u.User.Username()
+		uri.Password, _ = u.User.Password()
+	}
+	return uri, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/smartystreets/goconvey/convey"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func getEvent() *apicommon.Event {
+	return &apicommon.Event{
+		Context: apicommon.EventContext{
+			CloudEventsVersion: "1.0",
+			EventID:            "1234",
+			EventType:          "webhook",
+			EventTypeVersion:   "1.0",
+			ContentType:        "application/json",
+			EventTime:          metav1.MicroTime{Time: time.Date(2019, 3, 1, 10, 30, 0, 0, time.UTC)},
+			Source: &apicommon.URI{
+				Host: "webhook-gateway:foo",
+			},
+			Extensions: map[string]string{
+				"x-github-event": "push",
+			},
+		},
+		Payload: []byte(`{"name":"foo"}`),
+	}
+}
+
+func TestCloudEvents(t *testing.T) {
+	convey.Convey("Given an event", t, func() {
+		event := getEvent()
+
+		convey.Convey("The CloudEvents 0.1 envelope must be decoded as is", func() {
+			header, body, err := EncodeCloudEvent(event, apicommon.CloudEventsV01, "")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(header.Get("Content-Type"), convey.ShouldEqual, "application/json")
+
+			decoded, err := DecodeCloudEvent(header, body)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(decoded.Context.Source.Host, convey.ShouldEqual, "webhook-gateway:foo")
+			convey.So(decoded.Context.Extensions["x-github-event"], convey.ShouldEqual, "push")
+			convey.So(string(decoded.Payload), convey.ShouldEqual, `{"name":"foo"}`)
+		})
+
+		convey.Convey("A CloudEvents 1.0 event in binary mode must carry its attributes in headers", func() {
+			header, body, err := EncodeCloudEvent(event, apicommon.CloudEventsV1, apicommon.Binary)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(header.Get("Ce-Specversion"), convey.ShouldEqual, "1.0")
+			convey.So(header.Get("Ce-Source"), convey.ShouldEqual, "webhook-gateway:foo")
+			convey.So(header.Get("Ce-Time"), convey.ShouldEqual, "2019-03-01T10:30:00Z")
+			convey.So(header.Get("Ce-Xgithubevent"), convey.ShouldEqual, "push")
+			convey.So(header.Get("Content-Type"), convey.ShouldEqual, "application/json")
+			convey.So(string(body), convey.ShouldEqual, `{"name":"foo"}`)
+
+			decoded, err := DecodeCloudEvent(header, body)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(decoded.Context.EventID, convey.ShouldEqual, "1234")
+			convey.So(decoded.Context.EventType, convey.ShouldEqual, "webhook")
+			convey.So(decoded.Context.EventTypeVersion, convey.ShouldEqual, "1.0")
+			convey.So(decoded.Context.Source.Host, convey.ShouldEqual, "webhook-gateway:foo")
+			convey.So(decoded.Context.EventTime.Time.Equal(event.Context.EventTime.Time), convey.ShouldBeTrue)
+			convey.So(decoded.Context.Extensions["xgithubevent"], convey.ShouldEqual, "push")
+			convey.So(string(decoded.Payload), convey.ShouldEqual, `{"name":"foo"}`)
+		})
+
+		convey.Convey("The user info of the schema URL must be left out of the attributes", func() {
+			event.Context.SchemaURL = &apicommon.URI{
+				Scheme:   "https",
+				Host:     "schemas.example.com",
+				Path:     "/webhook.json",
+				User:     "admin",
+				Password: "secret",
+			}
+			header, _, err := EncodeCloudEvent(event, apicommon.CloudEventsV1, apicommon.Binary)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(header.Get("Ce-Dataschema"), convey.ShouldEqual, "https://schemas.example.com/webhook.json")
+		})
+
+		convey.Convey("Extensions carried as the same CloudEvents 1.0 attribute must be rejected", func() {
+			event.Context.Extensions["xgithubevent"] = "pull_request"
+			_, _, err := EncodeCloudEvent(event, apicommon.CloudEventsV1, apicommon.Binary)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("A CloudEvents 1.0 event in structured mode must carry its attributes in the body", func() {
+			header, body, err := EncodeCloudEvent(event, apicommon.CloudEventsV1, apicommon.Structured)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(header.Get("Content-Type"), convey.ShouldEqual, MediaTypeCloudEventsJSON)
+
+			decoded, err := DecodeCloudEvent(header, body)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(decoded.Context.CloudEventsVersion, convey.ShouldEqual, "1.0")
+			convey.So(decoded.Context.Source.Host, convey.ShouldEqual, "webhook-gateway:foo")
+			convey.So(decoded.Context.ContentType, convey.ShouldEqual, "application/json")
+			convey.So(string(decoded.Payload), convey.ShouldEqual, `{"name":"foo"}`)
+
+			convey.Convey("Data that isn't JSON must be carried in base64", func() {
+				event.Context.ContentType = "application/octet-stream"
+				event.Payload = []byte{0xff, 0x00}
+				_, body, err := EncodeCloudEvent(event, apicommon.CloudEventsV1, apicommon.Structured)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(string(body), convey.ShouldContainSubstring, `"data_base64":"/wA="`)
+
+				decoded, err := DecodeCloudEvent(nil, body)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(decoded.Payload, convey.ShouldResemble, []byte{0xff, 0x00})
+			})
+		})
+
+		convey.Convey("An event of a third party CloudEvents producer must be decoded", func() {
+			header := http.Header{}
+			header.Set("Ce-Specversion", "1.0")
+			header.Set("Ce-Id", "abc")
+			header.Set("Ce-Type", "com.example.order.created")
+			header.Set("Ce-Source", "orders:created")
+			header.Set("Ce-Subject", "order%20123")
+			header.Set("Content-Type", "text/plain")
+
+			decoded, err := DecodeCloudEvent(header, []byte("order 123 is created"))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(decoded.Context.Source.Host, convey.ShouldEqual, "orders:created")
+			convey.So(decoded.Context.Extensions["subject"], convey.ShouldEqual, "order 123")
+			convey.So(string(decoded.Payload), convey.ShouldEqual, "order 123 is created")
+
+			convey.Convey("An event without a required attribute must be rejected", func() {
+				header.Del("Ce-Source")
+				_, err := DecodeCloudEvent(header, nil)
+				convey.So(err, convey.ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package common
 
 import (
+	"time"
+
 	"github.com/argoproj/argo-events/pkg/apis/gateway"
 	"github.com/argoproj/argo-events/pkg/apis/sensor"
 )
@@ -67,12 +69,36 @@ const (
 	// SensorServiceEndpoint is the endpoint to dispatch the event to
 	SensorServiceEndpoint = "/"
 
+	// SensorReplayEndpoint is the endpoint to replay events to
+	SensorReplayEndpoint = "/replay"
+
+	// SensorHealthzEndpoint is the liveness endpoint of a sensor
+	SensorHealthzEndpoint = "/healthz"
+
+	// SensorReadyzEndpoint is the readiness endpoint of a sensor
+	SensorReadyzEndpoint = "/readyz"
+
+	// SensorDebugStateEndpoint is the endpoint exposing the state of a sensor
+	SensorDebugStateEndpoint = "/debug/state"
+
+	// DefaultSensorAdminPort is the port of the admin server of sensors that receive events over NATS
+	DefaultSensorAdminPort = "9300"
+
+	// DefaultSensorMaxBacklog is the number of notifications waiting to be processed beyond which a sensor is not ready
+	DefaultSensorMaxBacklog = 100
+
+	// EventExtensionReplayed is the extension that marks an event as replayed
+	EventExtensionReplayed = "replayed"
+
 	// SensorName refers env var for name of sensor
 	SensorName = "SENSOR_NAME"
 
 	// SensorNamespace is used to get namespace where sensors are deployed
 	SensorNamespace = "SENSOR_NAMESPACE"
 
+	// EnvVarSensorReplica refers env var for the index of the replica of a scaled out sensor
+	EnvVarSensorReplica = "SENSOR_REPLICA"
+
 	// LabelSensorName is label for sensor name
 	LabelSensorName = "sensor-name"
 
@@ -84,6 +110,15 @@ const (
 
 	// EnvVarSensorControllerInstanceID is used to get sensor controller instance id
 	EnvVarSensorControllerInstanceID = "SENSOR_CONTROLLER_INSTANCE_ID"
+
+	// DefaultSuspendBufferLimit is the default number of events buffered while a sensor is suspended
+	DefaultSuspendBufferLimit = 100
+
+	// DefaultFanOutMaxItems is the default maximum number of array elements a trigger is fanned out over
+	DefaultFanOutMaxItems = 100
+
+	// DefaultDeadLetterMaxEntries is the default maximum number of entries kept by a capped dead letter sink
+	DefaultDeadLetterMaxEntries = 100
 )
 
 // GATEWAY CONSTANTS
@@ -133,11 +168,32 @@ const (
 const (
 	// EnvVarGatewayEventSourceConfigMap is used to get map containing event sources to run in a gateway
 	EnvVarGatewayEventSourceConfigMap = "GATEWAY_EVENT_SOURCE_CONFIG_MAP"
+
+	// DefaultGatewayMetricsPort is the port on which the gateway client serves its metrics
+	DefaultGatewayMetricsPort = "9301"
+
+	// GatewayMetricsEndpoint is the endpoint on which the gateway client serves its metrics
+	GatewayMetricsEndpoint = "/debug/vars"
+
+	// DefaultEventSourceMaxRestarts is the default number of consecutive restarts of a failed event source
+	DefaultEventSourceMaxRestarts = 10
+
+	// DefaultEventSourceRestartInitialDelay is the default delay before a failed event source is restarted
+	DefaultEventSourceRestartInitialDelay = 1 * time.Second
+
+	// DefaultEventSourceRestartMaxDelay is the default maximum delay between the restarts of a failed event source
+	DefaultEventSourceRestartMaxDelay = 5 * time.Minute
 )
 
 // Gateway server constants
 const (
 	EnvVarGatewayServerPort = "GATEWAY_SERVER_PORT"
+	// EnvVarGatewayServerTLSCert is the path to the certificate the gateway server serves TLS with
+	EnvVarGatewayServerTLSCert = "GATEWAY_SERVER_TLS_CERT"
+	// EnvVarGatewayServerTLSKey is the path to the key of the certificate of the gateway server
+	EnvVarGatewayServerTLSKey = "GATEWAY_SERVER_TLS_KEY"
+	// EnvVarGatewayServerTLSClientCA is the path to the CA certificate the certificates of the gateway clients are verified with, for mutual TLS
+	EnvVarGatewayServerTLSClientCA = "GATEWAY_SERVER_TLS_CLIENT_CA"
 )
 
 // CloudEvents constants
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package sensors
+package common
 
 import (
 	"encoding/json"
@@ -22,12 +22,10 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ghodss/yaml"
 
-	"time"
-
-	"github.com/argoproj/argo-events/common"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/tidwall/gjson"
@@ -42,44 +40,41 @@ const (
 	MediaTypeYAML string = "application/yaml"
 )
 
-// apply the eventDependency filters to an event
-func (sec *sensorExecutionCtx) filterEvent(f v1alpha1.EventDependencyFilter, event *apicommon.Event) (bool, error) {
-	dataRes, err := sec.filterData(f.Data, event)
+// FilterEvent applies the filters to an event. Sensors apply the filters of their event dependencies,
+// gateways the filters of their event sources.
+func FilterEvent(f v1alpha1.EventDependencyFilter, event *apicommon.Event) (bool, error) {
+	dataRes, err := FilterData(f.Data, event)
 	if err != nil {
 		return false, err
 	}
-	timeRes, err := sec.filterTime(f.Time, &event.Context.EventTime)
+	timeRes, err := FilterTime(f.Time, &event.Context.EventTime)
 	if err != nil {
 		return false, err
 	}
-	ctxRes := sec.filterContext(f.Context, &event.Context)
+	ctxRes := FilterContext(f.Context, &event.Context)
 	return timeRes && ctxRes && dataRes, err
 }
 
-// applyTimeFilter checks the eventTime against the timeFilter:
+// FilterTime checks the eventTime against the timeFilter:
 // 1. the eventTime is greater than or equal to the start time
 // 2. the eventTime is less than the end time
 // returns true if 1 and 2 are true and false otherwise
-func (sec *sensorExecutionCtx) filterTime(timeFilter *v1alpha1.TimeFilter, eventTime *metav1.MicroTime) (bool, error) {
+func FilterTime(timeFilter *v1alpha1.TimeFilter, eventTime *metav1.MicroTime) (bool, error) {
 	if timeFilter != nil {
-		sec.log.Info().Str("time", eventTime.String()).Msg("event time")
 		utc := time.Now().UTC()
-		currentTime := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC).Format(common.StandardYYYYMMDDFormat)
-		sec.log.Info().Str("time", currentTime).Msg("current time")
+		currentTime := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC).Format(StandardYYYYMMDDFormat)
 
 		if timeFilter.Start != "" && timeFilter.Stop != "" {
-			startTime, err := time.Parse(common.StandardTimeFormat, fmt.Sprintf("%s %s", currentTime, timeFilter.Start))
+			startTime, err := time.Parse(StandardTimeFormat, fmt.Sprintf("%s %s", currentTime, timeFilter.Start))
 			if err != nil {
 				return false, err
 			}
-			sec.log.Info().Str("time", startTime.String()).Msg("start time")
 			startTime = startTime.UTC()
 
-			stopTime, err := time.Parse(common.StandardTimeFormat, fmt.Sprintf("%s %s", currentTime, timeFilter.Stop))
+			stopTime, err := time.Parse(StandardTimeFormat, fmt.Sprintf("%s %s", currentTime, timeFilter.Stop))
 			if err != nil {
 				return false, err
 			}
-			sec.log.Info().Str("time", stopTime.String()).Msg("stop time")
 			stopTime = stopTime.UTC()
 
 			return (startTime.Before(eventTime.Time) || stopTime.Equal(eventTime.Time)) && eventTime.Time.Before(stopTime), nil
@@ -87,23 +82,21 @@ func (sec *sensorExecutionCtx) filterTime(timeFilter *v1alpha1.TimeFilter, event
 
 		if timeFilter.Start != "" {
 			// stop is nil - does not have an end
-			startTime, err := time.Parse(common.StandardTimeFormat, fmt.Sprintf("%s %s", currentTime, timeFilter.Start))
+			startTime, err := time.Parse(StandardTimeFormat, fmt.Sprintf("%s %s", currentTime, timeFilter.Start))
 			if err != nil {
 				return false, err
 			}
 
-			sec.log.Info().Str("time", startTime.String()).Msg("start time")
 			startTime = startTime.UTC()
 			return startTime.Before(eventTime.Time) || startTime.Equal(eventTime.Time), nil
 		}
 
 		if timeFilter.Stop != "" {
-			stopTime, err := time.Parse(common.StandardTimeFormat, fmt.Sprintf("%s %s", currentTime, timeFilter.Stop))
+			stopTime, err := time.Parse(StandardTimeFormat, fmt.Sprintf("%s %s", currentTime, timeFilter.Stop))
 			if err != nil {
 				return false, err
 			}
 
-			sec.log.Info().Str("time", stopTime.String()).Msg("stop time")
 			stopTime = stopTime.UTC()
 			return eventTime.Time.Before(stopTime), nil
 		}
@@ -111,10 +104,10 @@ func (sec *sensorExecutionCtx) filterTime(timeFilter *v1alpha1.TimeFilter, event
 	return true, nil
 }
 
-// applyContextFilter checks the expected EventContext against the actual EventContext
+// FilterContext checks the expected EventContext against the actual EventContext
 // values are only enforced if they are non-zero values
 // map types check that the expected map is a subset of the actual map
-func (sec *sensorExecutionCtx) filterContext(expected *apicommon.EventContext, actual *apicommon.EventContext) bool {
+func FilterContext(expected *apicommon.EventContext, actual *apicommon.EventContext) bool {
 	if expected == nil {
 		return true
 	}
@@ -144,10 +137,10 @@ func (sec *sensorExecutionCtx) filterContext(expected *apicommon.EventContext, a
 	return res && eExtensionRes
 }
 
-// applyDataFilter runs the dataFilter against the event's data
+// FilterData runs the dataFilter against the event's data
 // returns (true, nil) when data passes filters, false otherwise
 // TODO: split this function up into smaller pieces
-func (sec *sensorExecutionCtx) filterData(data *v1alpha1.Data, event *apicommon.Event) (bool, error) {
+func FilterData(data *v1alpha1.Data, event *apicommon.Event) (bool, error) {
 	// TODO: use the event.Context.SchemaURL to figure out correct data format to unmarshal to
 	// for now, let's just use a simple map[string]interface{} for arbitrary data
 	if data == nil {
@@ -163,7 +156,7 @@ func (sec *sensorExecutionCtx) filterData(data *v1alpha1.Data, event *apicommon.
 	if event.Payload == nil || len(event.Payload) == 0 {
 		return true, nil
 	}
-	js, err := renderEventDataAsJSON(event)
+	js, err := RenderEventDataAsJSON(event)
 	if err != nil {
 		return false, err
 	}
@@ -214,14 +207,9 @@ func mapIsSubset(sub map[string]string, m map[string]string) bool {
 	return true
 }
 
-func isJSON(b []byte) bool {
-	var js json.RawMessage
-	return json.Unmarshal(b, &js) == nil
-}
-
-// util method to render an event's data as a JSON []byte
+// RenderEventDataAsJSON renders an event's data as a JSON []byte
 // json is a subset of yaml so this should work...
-func renderEventDataAsJSON(e *apicommon.Event) ([]byte, error) {
+func RenderEventDataAsJSON(e *apicommon.Event) ([]byte, error) {
 	if e == nil {
 		return nil, fmt.Errorf("event is nil")
 	}
@@ -230,7 +218,7 @@ func renderEventDataAsJSON(e *apicommon.Event) ([]byte, error) {
 	contents := strings.Split(e.Context.ContentType, ";")
 	switch contents[0] {
 	case MediaTypeJSON:
-		if isJSON(raw) {
+		if json.Valid(raw) {
 			return raw, nil
 		}
 		return nil, fmt.Errorf("event data is not valid JSON")
@@ -13,80 +13,73 @@ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 See the License for the specific language governing permissions and
 limitations under the License.
 */
-package sensors
+
+package common
 
 import (
 	"reflect"
 	"testing"
 	"time"
 
-	"github.com/argoproj/argo-events/common"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func Test_filterTime(t *testing.T) {
+func TestFilterTime(t *testing.T) {
 	timeFilter := &v1alpha1.TimeFilter{
 		Stop:  "17:14:00",
 		Start: "10:11:00",
 	}
-	event := getCloudEvent()
+	event := getEvent()
 
 	currentT := time.Now().UTC()
 	currentT = time.Date(currentT.Year(), currentT.Month(), currentT.Day(), 0, 0, 0, 0, time.UTC)
-	currentTStr := currentT.Format(common.StandardYYYYMMDDFormat)
-	parsedTime, err := time.Parse(common.StandardTimeFormat, currentTStr+" 16:36:34")
+	currentTStr := currentT.Format(StandardYYYYMMDDFormat)
+	parsedTime, err := time.Parse(StandardTimeFormat, currentTStr+" 16:36:34")
 	assert.Nil(t, err)
 	event.Context.EventTime = metav1.MicroTime{
 		Time: parsedTime,
 	}
-	sensor, err := getSensor()
-	assert.Nil(t, err)
-	sOptCtx := getsensorExecutionCtx(sensor)
-	valid, err := sOptCtx.filterTime(timeFilter, &event.Context.EventTime)
+	valid, err := FilterTime(timeFilter, &event.Context.EventTime)
 	assert.Nil(t, err)
 	assert.Equal(t, true, valid)
 
 	// test invalid event
 	timeFilter.Start = "09:09:09"
 	timeFilter.Stop = "09:10:09"
-	valid, err = sOptCtx.filterTime(timeFilter, &event.Context.EventTime)
+	valid, err = FilterTime(timeFilter, &event.Context.EventTime)
 	assert.Nil(t, err)
 	assert.Equal(t, false, valid)
 
 	// test no stop
 	timeFilter.Start = "09:09:09"
 	timeFilter.Stop = ""
-	valid, err = sOptCtx.filterTime(timeFilter, &event.Context.EventTime)
+	valid, err = FilterTime(timeFilter, &event.Context.EventTime)
 	assert.Nil(t, err)
 	assert.Equal(t, true, valid)
 
 	// test no start
 	timeFilter.Start = ""
 	timeFilter.Stop = "17:09:09"
-	valid, err = sOptCtx.filterTime(timeFilter, &event.Context.EventTime)
+	valid, err = FilterTime(timeFilter, &event.Context.EventTime)
 	assert.Nil(t, err)
 	assert.Equal(t, true, valid)
 }
 
-func Test_filterContext(t *testing.T) {
-	event := getCloudEvent()
+func TestFilterContext(t *testing.T) {
+	event := getEvent()
 	assert.NotNil(t, event)
-	sensor, err := getSensor()
-	assert.Nil(t, err)
-	sOptCtx := getsensorExecutionCtx(sensor)
-	assert.NotNil(t, sOptCtx)
 	testCtx := event.Context.DeepCopy()
-	valid := sOptCtx.filterContext(testCtx, &event.Context)
+	valid := FilterContext(testCtx, &event.Context)
 	assert.Equal(t, true, valid)
 	testCtx.Source.Host = "dummy source"
-	valid = sOptCtx.filterContext(testCtx, &event.Context)
+	valid = FilterContext(testCtx, &event.Context)
 	assert.Equal(t, false, valid)
 }
 
-func Test_filterData(t *testing.T) {
+func TestFilterData(t *testing.T) {
 	type args struct {
 		data  *v1alpha1.Data
 		event *apicommon.Event
@@ -203,24 +196,21 @@ func Test_filterData(t *testing.T) {
 			wantErr: false,
 		},
 	}
-	sensor, err := getSensor()
-	assert.Nil(t, err)
-	sOptCtx := getsensorExecutionCtx(sensor)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := sOptCtx.filterData(tt.args.data, tt.args.event)
+			got, err := FilterData(tt.args.data, tt.args.event)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("filterData() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("FilterData() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if got != tt.want {
-				t.Errorf("filterData() = %v, want %v", got, tt.want)
+				t.Errorf("FilterData() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func Test_mapIsSubset(t *testing.T) {
+func TestMapIsSubset(t *testing.T) {
 	type args struct {
 		sub map[string]string
 		m   map[string]string
@@ -266,7 +256,7 @@ func Test_mapIsSubset(t *testing.T) {
 }
 
 // this test is meant to cover the missing cases for those not covered in eventDependency-filter_test.go and trigger-params_test.go
-func Test_renderEventDataAsJSON(t *testing.T) {
+func TestRenderEventDataAsJSON(t *testing.T) {
 	type args struct {
 		e *apicommon.Event
 	}
@@ -335,13 +325,13 @@ func Test_renderEventDataAsJSON(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := renderEventDataAsJSON(tt.args.e)
+			got, err := RenderEventDataAsJSON(tt.args.e)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("renderEventDataAsJSON() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("RenderEventDataAsJSON() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("renderEventDataAsJSON() = %v, want %v", got, tt.want)
+				t.Errorf("RenderEventDataAsJSON() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -0,0 +1,46 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"time"
+
+	cronlib "github.com/robfig/cron"
+)
+
+// ResolveSchedule resolves either a standard cron expression or an interval duration into a schedule.
+// The cron expression takes precedence over the interval.
+func ResolveSchedule(schedule string, interval string) (cronlib.Schedule, error) {
+	if schedule != "" {
+		// standard cron expression
+		specParser := cronlib.NewParser(cronlib.Minute | cronlib.Hour | cronlib.Dom | cronlib.Month | cronlib.Dow)
+		s, err := specParser.Parse(schedule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse schedule %s. Cause: %+v", schedule, err.Error())
+		}
+		return s, nil
+	} else if interval != "" {
+		intervalDuration, err := time.ParseDuration(interval)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse interval %s. Cause: %+v", interval, err.Error())
+		}
+		return cronlib.ConstantDelaySchedule{Delay: intervalDuration}, nil
+	} else {
+		return nil, fmt.Errorf("must contain either a schedule or interval")
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestResolveSchedule(t *testing.T) {
+	convey.Convey("Given a cron expression, resolve the schedule", t, func() {
+		schedule, err := ResolveSchedule("0 3 * * *", "10s")
+		convey.So(err, convey.ShouldBeNil)
+		from := time.Date(2018, 1, 1, 2, 0, 0, 0, time.UTC)
+		convey.So(schedule.Next(from), convey.ShouldEqual, time.Date(2018, 1, 1, 3, 0, 0, 0, time.UTC))
+	})
+
+	convey.Convey("Given an interval, resolve the schedule", t, func() {
+		schedule, err := ResolveSchedule("", "10s")
+		convey.So(err, convey.ShouldBeNil)
+		from := time.Date(2018, 1, 1, 2, 0, 0, 0, time.UTC)
+		convey.So(schedule.Next(from), convey.ShouldEqual, from.Add(10*time.Second))
+	})
+
+	convey.Convey("Given neither a cron expression nor an interval, fail to resolve the schedule", t, func() {
+		_, err := ResolveSchedule("", "")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
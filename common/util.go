@@ -52,6 +52,21 @@ func DefaultNatsQueueName(subject string) string {
 	return fmt.Sprintf("%s-%s", subject, "queue")
 }
 
+// DefaultSensorReplicaName returns a formulated name for the pod of a replica of a sensor
+func DefaultSensorReplicaName(sensorName string, replica int) string {
+	return fmt.Sprintf("%s-%d", sensorName, replica)
+}
+
+// DefaultSensorPartitionName returns a formulated name for the configmap holding the state of the partition of a replica of a sensor
+func DefaultSensorPartitionName(sensorName string, replica int) string {
+	return fmt.Sprintf("%s-partition-%d", sensorName, replica)
+}
+
+// DefaultSensorLeaderLeaseName returns a formulated name for the configmap holding the leader lease of a sensor
+func DefaultSensorLeaderLeaseName(sensorName string) string {
+	return fmt.Sprintf("%s-leader", sensorName)
+}
+
 // GetClientConfig return rest config, if path not specified, assume in cluster config
 func GetClientConfig(kubeconfig string) (*rest.Config, error) {
 	if kubeconfig != "" {
@@ -86,6 +101,12 @@ func SendErrorResponse(writer http.ResponseWriter, response string) {
 	writer.Write([]byte(response))
 }
 
+// SendInternalErrorResponse sends http internal error response
+func SendInternalErrorResponse(writer http.ResponseWriter, response string) {
+	writer.WriteHeader(http.StatusInternalServerError)
+	writer.Write([]byte(response))
+}
+
 // LoggerConf returns standard logging configuration
 func LoggerConf() zerolog.ConsoleWriter {
 	output := zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339}
@@ -18,8 +18,15 @@ package gateway
 
 import (
 	"fmt"
+	"net"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
+	sv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Validate validates the gateway resource.
@@ -58,5 +65,153 @@ func Validate(gw *v1alpha1.Gateway) error {
 	default:
 		return fmt.Errorf("unknown gateway type")
 	}
+	switch gw.Spec.EventProtocol.CloudEventsVersion {
+	case "", apicommon.CloudEventsV01, apicommon.CloudEventsV1:
+	default:
+		return fmt.Errorf("unknown cloudevents version %s", gw.Spec.EventProtocol.CloudEventsVersion)
+	}
+	switch gw.Spec.EventProtocol.CloudEventsMode {
+	case "", apicommon.Binary, apicommon.Structured:
+	default:
+		return fmt.Errorf("unknown cloudevents mode %s", gw.Spec.EventProtocol.CloudEventsMode)
+	}
+	if err := validateOutbox(gw.Spec.Outbox); err != nil {
+		return err
+	}
+	if err := validateRestartPolicy(gw.Spec.RestartPolicy); err != nil {
+		return err
+	}
+	if err := validateServer(gw.Spec.Server); err != nil {
+		return err
+	}
+	if err := validateWatcherRouting(gw); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateOutbox validates the outbox of the gateway
+func validateOutbox(outbox *v1alpha1.Outbox) error {
+	if outbox == nil {
+		return nil
+	}
+	if outbox.Path == "" {
+		return fmt.Errorf("outbox path is not specified")
+	}
+	if outbox.MaxSize != "" {
+		size, err := resource.ParseQuantity(outbox.MaxSize)
+		if err != nil {
+			return fmt.Errorf("failed to parse outbox max size. err: %+v", err)
+		}
+		if size.Value() <= 0 {
+			return fmt.Errorf("outbox max size must be positive")
+		}
+	}
+	if outbox.MaxAge != "" {
+		age, err := time.ParseDuration(outbox.MaxAge)
+		if err != nil {
+			return fmt.Errorf("failed to parse outbox max age. err: %+v", err)
+		}
+		if age <= 0 {
+			return fmt.Errorf("outbox max age must be positive")
+		}
+	}
+	return nil
+}
+
+// validateRestartPolicy validates the restart policy of the event sources
+func validateRestartPolicy(policy *v1alpha1.EventSourceRestartPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.MaxRestarts != nil && *policy.MaxRestarts < 0 {
+		return fmt.Errorf("max restarts of event sources must not be negative")
+	}
+	// the delays that are not set default to the delays of the gateway client
+	initialDelay, maxDelay := common.DefaultEventSourceRestartInitialDelay, common.DefaultEventSourceRestartMaxDelay
+	var err error
+	if policy.InitialDelay != "" {
+		if initialDelay, err = time.ParseDuration(policy.InitialDelay); err != nil {
+			return fmt.Errorf("failed to parse initial restart delay. err: %+v", err)
+		}
+		if initialDelay <= 0 {
+			return fmt.Errorf("initial restart delay must be positive")
+		}
+	}
+	if policy.MaxDelay != "" {
+		if maxDelay, err = time.ParseDuration(policy.MaxDelay); err != nil {
+			return fmt.Errorf("failed to parse max restart delay. err: %+v", err)
+		}
+		if maxDelay <= 0 {
+			return fmt.Errorf("max restart delay must be positive")
+		}
+	}
+	if initialDelay > maxDelay {
+		return fmt.Errorf("initial restart delay must not be greater than max restart delay")
+	}
+	return nil
+}
+
+// validateServer validates the remote gateway server of the gateway
+func validateServer(server *v1alpha1.GatewayServer) error {
+	if server == nil {
+		return nil
+	}
+	if server.Address == "" {
+		return fmt.Errorf("gateway server address is not specified")
+	}
+	if _, _, err := net.SplitHostPort(server.Address); err != nil {
+		return fmt.Errorf("failed to parse gateway server address. err: %+v", err)
+	}
+	if server.TLS == nil {
+		return nil
+	}
+	if (server.TLS.ClientCertSecret == nil) != (server.TLS.ClientKeySecret == nil) {
+		return fmt.Errorf("client certificate and client key must be specified together")
+	}
+	for _, selector := range []*corev1.SecretKeySelector{server.TLS.CACertSecret, server.TLS.ClientCertSecret, server.TLS.ClientKeySecret} {
+		if selector != nil && (selector.Name == "" || selector.Key == "") {
+			return fmt.Errorf("secret name and key of gateway server certificates must be specified")
+		}
+	}
+	return nil
+}
+
+// validateWatcherRouting validates the routing of the events of the gateway to its watchers
+func validateWatcherRouting(gw *v1alpha1.Gateway) error {
+	if gw.Spec.Watchers == nil {
+		return nil
+	}
+	var routings []*v1alpha1.WatcherRouting
+	for _, sensor := range gw.Spec.Watchers.Sensors {
+		if sensor.Routing != nil {
+			routings = append(routings, sensor.Routing)
+		}
+	}
+	for _, gateway := range gw.Spec.Watchers.Gateways {
+		if gateway.Routing != nil {
+			routings = append(routings, gateway.Routing)
+		}
+	}
+	if len(routings) > 0 && gw.Spec.EventProtocol.Type != apicommon.HTTP {
+		return fmt.Errorf("watcher routing is only supported when events are dispatched over http")
+	}
+	for _, routing := range routings {
+		for _, name := range routing.EventSources {
+			if name == "" {
+				return fmt.Errorf("event source name of watcher routing must not be empty")
+			}
+		}
+		if routing.Filter == nil || routing.Filter.Data == nil {
+			continue
+		}
+		for _, filter := range routing.Filter.Data.Filters {
+			switch filter.Type {
+			case sv1alpha1.JSONTypeBool, sv1alpha1.JSONTypeNumber, sv1alpha1.JSONTypeString:
+			default:
+				return fmt.Errorf("unsupported JSON type %s of watcher routing filter", filter.Type)
+			}
+		}
+	}
 	return nil
 }
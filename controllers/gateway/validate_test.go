@@ -19,7 +19,11 @@ package gateway
 import (
 	"testing"
 
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
+	sv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/smartystreets/goconvey/convey"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestValidate(t *testing.T) {
@@ -33,5 +37,127 @@ func TestValidate(t *testing.T) {
 			err := Validate(gateway)
 			convey.So(err, convey.ShouldBeNil)
 		})
+
+		convey.Convey("Make sure the outbox is validated", func() {
+			convey.So(err, convey.ShouldBeNil)
+			gateway.Spec.Outbox = &v1alpha1.Outbox{
+				MaxSize: "10Mi",
+			}
+			convey.So(Validate(gateway), convey.ShouldNotBeNil)
+
+			gateway.Spec.Outbox.Path = "/outbox"
+			convey.So(Validate(gateway), convey.ShouldBeNil)
+
+			gateway.Spec.Outbox.MaxAge = "a day"
+			convey.So(Validate(gateway), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Make sure the restart policy is validated", func() {
+			convey.So(err, convey.ShouldBeNil)
+			maxRestarts := int32(5)
+			gateway.Spec.RestartPolicy = &v1alpha1.EventSourceRestartPolicy{
+				MaxRestarts:  &maxRestarts,
+				InitialDelay: "1s",
+				MaxDelay:     "1m",
+			}
+			convey.So(Validate(gateway), convey.ShouldBeNil)
+
+			gateway.Spec.RestartPolicy.InitialDelay = "2m"
+			convey.So(Validate(gateway), convey.ShouldNotBeNil)
+
+			// the initial delay is compared to the default max delay
+			gateway.Spec.RestartPolicy.MaxDelay = ""
+			gateway.Spec.RestartPolicy.InitialDelay = "10m"
+			convey.So(Validate(gateway), convey.ShouldNotBeNil)
+
+			// the max delay is compared to the default initial delay
+			gateway.Spec.RestartPolicy.InitialDelay = ""
+			gateway.Spec.RestartPolicy.MaxDelay = "500ms"
+			convey.So(Validate(gateway), convey.ShouldNotBeNil)
+
+			gateway.Spec.RestartPolicy.MaxDelay = ""
+			maxRestarts = -1
+			convey.So(Validate(gateway), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Make sure the cloudevents version and mode are validated", func() {
+			convey.So(err, convey.ShouldBeNil)
+			gateway.Spec.EventProtocol.CloudEventsVersion = apicommon.CloudEventsV1
+			gateway.Spec.EventProtocol.CloudEventsMode = apicommon.Binary
+			convey.So(Validate(gateway), convey.ShouldBeNil)
+
+			gateway.Spec.EventProtocol.CloudEventsVersion = "0.3"
+			convey.So(Validate(gateway), convey.ShouldNotBeNil)
+
+			gateway.Spec.EventProtocol.CloudEventsVersion = apicommon.CloudEventsV1
+			gateway.Spec.EventProtocol.CloudEventsMode = "batched"
+			convey.So(Validate(gateway), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Make sure the watcher routing is validated", func() {
+			convey.So(err, convey.ShouldBeNil)
+			gateway.Spec.EventProtocol.Type = apicommon.HTTP
+			gateway.Spec.EventProtocol.Http.Port = "9300"
+			gateway.Spec.Watchers = &v1alpha1.NotificationWatchers{
+				Sensors: []v1alpha1.SensorNotificationWatcher{
+					{
+						Name: "webhook-sensor",
+					},
+				},
+			}
+			convey.So(Validate(gateway), convey.ShouldBeNil)
+
+			gateway.Spec.Watchers.Sensors[0].Routing = &v1alpha1.WatcherRouting{
+				EventSources: []string{"foo"},
+				Filter: &sv1alpha1.EventDependencyFilter{
+					Data: &sv1alpha1.Data{
+						Filters: []*sv1alpha1.DataFilter{
+							{
+								Path:  "sender.type",
+								Type:  sv1alpha1.JSONTypeString,
+								Value: "User",
+							},
+						},
+					},
+				},
+			}
+			convey.So(Validate(gateway), convey.ShouldBeNil)
+
+			gateway.Spec.Watchers.Sensors[0].Routing.Filter.Data.Filters[0].Type = "object"
+			convey.So(Validate(gateway), convey.ShouldNotBeNil)
+
+			gateway.Spec.Watchers.Sensors[0].Routing.Filter = nil
+			gateway.Spec.EventProtocol.Type = apicommon.NATS
+			convey.So(Validate(gateway), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Make sure the gateway server is validated", func() {
+			convey.So(err, convey.ShouldBeNil)
+			gateway.Spec.Server = &v1alpha1.GatewayServer{
+				Address: "calendar-gateway-server.event-sources.svc",
+			}
+			convey.So(Validate(gateway), convey.ShouldNotBeNil)
+
+			gateway.Spec.Server.Address = "calendar-gateway-server.event-sources.svc:9330"
+			convey.So(Validate(gateway), convey.ShouldBeNil)
+
+			gateway.Spec.Server.TLS = &v1alpha1.GatewayServerTLS{
+				ClientCertSecret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: "gateway-client-tls",
+					},
+					Key: "tls.crt",
+				},
+			}
+			convey.So(Validate(gateway), convey.ShouldNotBeNil)
+
+			gateway.Spec.Server.TLS.ClientKeySecret = &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: "gateway-client-tls",
+				},
+				Key: "tls.key",
+			}
+			convey.So(Validate(gateway), convey.ShouldBeNil)
+		})
 	})
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package sensor
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/argoproj/argo-events/common"
@@ -135,23 +136,16 @@ func (soc *sOperationCtx) operate() error {
 		}
 		soc.s.ObjectMeta.Labels[common.LabelSensorName] = soc.s.Name
 
-		sensorPod := &corev1.Pod{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      soc.s.Name,
-				Namespace: soc.s.Namespace,
-				Labels:    soc.s.Labels,
-				OwnerReferences: []metav1.OwnerReference{
-					*metav1.NewControllerRef(soc.s, v1alpha1.SchemaGroupVersionKind),
-				},
-			},
-			Spec: *soc.s.Spec.DeploySpec,
-		}
-		_, err = soc.controller.kubeClientset.CoreV1().Pods(soc.s.Namespace).Create(sensorPod)
-		if err != nil {
-			soc.log.Error().Err(err).Msg("failed to create sensor pod")
+		if soc.s.Spec.Scaling != nil && soc.s.Spec.Scaling.Replicas > 1 {
+			// a scaled out sensor runs a pod per replica
+			for replica := 0; replica < int(soc.s.Spec.Scaling.Replicas); replica++ {
+				if err = soc.createSensorPod(common.DefaultSensorReplicaName(soc.s.Name, replica), replica); err != nil {
+					return err
+				}
+			}
+		} else if err = soc.createSensorPod(soc.s.Name, 0); err != nil {
 			return err
 		}
-		soc.log.Info().Msg("sensor pod created")
 
 		// Create a ClusterIP service to expose sensor in cluster if the event protocol type is HTTP
 		if _, err = soc.controller.kubeClientset.CoreV1().Services(soc.s.Namespace).Get(common.DefaultServiceName(soc.s.Name), metav1.GetOptions{}); err != nil && apierr.IsNotFound(err) && soc.s.Spec.EventProtocol.Type == pc.HTTP {
@@ -200,6 +194,58 @@ func (soc *sOperationCtx) operate() error {
 	return nil
 }
 
+// createSensorPod creates the pod of a replica of the sensor
+func (soc *sOperationCtx) createSensorPod(name string, replica int) error {
+	spec := soc.s.Spec.DeploySpec.DeepCopy()
+	spec.Containers[0].Env = append(spec.Containers[0].Env, corev1.EnvVar{
+		Name:  common.EnvVarSensorReplica,
+		Value: strconv.Itoa(replica),
+	})
+	// probe the health and readiness endpoints of the sensor, unless the user configured the probes
+	port := common.DefaultSensorAdminPort
+	if soc.s.Spec.EventProtocol.Type == pc.HTTP {
+		port = soc.s.Spec.EventProtocol.Http.Port
+	}
+	if spec.Containers[0].LivenessProbe == nil {
+		spec.Containers[0].LivenessProbe = sensorProbe(common.SensorHealthzEndpoint, port)
+	}
+	if spec.Containers[0].ReadinessProbe == nil {
+		spec.Containers[0].ReadinessProbe = sensorProbe(common.SensorReadyzEndpoint, port)
+	}
+	sensorPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: soc.s.Namespace,
+			Labels:    soc.s.Labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(soc.s, v1alpha1.SchemaGroupVersionKind),
+			},
+		},
+		Spec: *spec,
+	}
+	if _, err := soc.controller.kubeClientset.CoreV1().Pods(soc.s.Namespace).Create(sensorPod); err != nil {
+		soc.log.Error().Err(err).Str("pod-name", name).Msg("failed to create sensor pod")
+		return err
+	}
+	soc.log.Info().Str("pod-name", name).Msg("sensor pod created")
+	return nil
+}
+
+// sensorProbe returns a probe of the endpoint of the sensor
+func sensorProbe(path string, port string) *corev1.Probe {
+	return &corev1.Probe{
+		Handler: corev1.Handler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: path,
+				Port: intstr.Parse(port),
+			},
+		},
+		InitialDelaySeconds: 10,
+		PeriodSeconds:       10,
+		FailureThreshold:    3,
+	}
+}
+
 // mark the overall sensor phase
 func (soc *sOperationCtx) markSensorPhase(phase v1alpha1.NodePhase, markComplete bool, message ...string) {
 	justCompleted := soc.s.Status.Phase != phase
@@ -17,7 +17,10 @@ limitations under the License.
 package sensor
 
 import (
+	"strconv"
+
 	"github.com/argoproj/argo-events/common"
+	pc "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/ghodss/yaml"
 	"github.com/smartystreets/goconvey/convey"
@@ -117,6 +120,9 @@ func TestSensorOperations(t *testing.T) {
 					sensorDeployment, err := controller.kubeClientset.CoreV1().Pods(soc.s.Namespace).Get(soc.s.Name, metav1.GetOptions{})
 					convey.So(err, convey.ShouldBeNil)
 					convey.So(sensorDeployment, convey.ShouldNotBeNil)
+					convey.So(sensorDeployment.Spec.Containers[0].LivenessProbe.HTTPGet.Path, convey.ShouldEqual, common.SensorHealthzEndpoint)
+					convey.So(sensorDeployment.Spec.Containers[0].ReadinessProbe.HTTPGet.Path, convey.ShouldEqual, common.SensorReadyzEndpoint)
+					convey.So(sensorDeployment.Spec.Containers[0].ReadinessProbe.HTTPGet.Port.IntValue(), convey.ShouldEqual, 9300)
 
 					sensorSvc, err := controller.kubeClientset.CoreV1().Services(soc.s.Namespace).Get(common.DefaultServiceName(soc.s.Name), metav1.GetOptions{})
 					convey.So(err, convey.ShouldBeNil)
@@ -138,3 +144,37 @@ func TestSensorOperations(t *testing.T) {
 		})
 	})
 }
+
+func TestScaledOutSensorOperations(t *testing.T) {
+	convey.Convey("Given a scaled out sensor", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sensor.Spec.EventProtocol = &v1alpha1.EventProtocol{
+			Type: pc.NATS,
+			Nats: v1alpha1.Nats{
+				URL:  "nats://nats.argo-events:4222",
+				Type: pc.Standard,
+			},
+		}
+		sensor.Spec.Scaling = &v1alpha1.Scaling{
+			Replicas: 3,
+		}
+
+		controller := getSensorController()
+		sensor, err = controller.sensorClientset.ArgoprojV1alpha1().Sensors(sensor.Namespace).Create(sensor)
+		convey.So(err, convey.ShouldBeNil)
+		soc := newSensorOperationCtx(sensor, controller)
+
+		convey.Convey("A pod must be created per replica", func() {
+			convey.So(soc.operate(), convey.ShouldBeNil)
+			convey.So(soc.s.Status.Phase, convey.ShouldEqual, v1alpha1.NodePhaseActive)
+			for replica := 0; replica < 3; replica++ {
+				pod, err := controller.kubeClientset.CoreV1().Pods(soc.s.Namespace).Get(common.DefaultSensorReplicaName(soc.s.Name, replica), metav1.GetOptions{})
+				convey.So(err, convey.ShouldBeNil)
+				env := pod.Spec.Containers[0].Env
+				convey.So(env[len(env)-1].Name, convey.ShouldEqual, common.EnvVarSensorReplica)
+				convey.So(env[len(env)-1].Value, convey.ShouldEqual, strconv.Itoa(replica))
+			}
+		})
+	})
+}
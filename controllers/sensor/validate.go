@@ -18,6 +18,7 @@ package sensor
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/argoproj/argo-events/common"
@@ -37,6 +38,24 @@ func ValidateSensor(s *v1alpha1.Sensor) error {
 	if err != nil {
 		return err
 	}
+	if err := validateFanOuts(s.Spec.Triggers, s.Spec.Dependencies); err != nil {
+		return err
+	}
+	if err := validateSuspendPolicy(s.Spec.SuspendPolicy); err != nil {
+		return err
+	}
+	if err := validateSequence(s.Spec.Sequence); err != nil {
+		return err
+	}
+	if err := validateDeadLetterSink(s.Spec.DeadLetter); err != nil {
+		return err
+	}
+	if err := validateStateStore(s.Spec.StateStore); err != nil {
+		return err
+	}
+	if err := validateScaling(s); err != nil {
+		return err
+	}
 	if len(s.Spec.DeploySpec.Containers) > 1 {
 		return fmt.Errorf("sensor pod specification can't have more than one container")
 	}
@@ -58,12 +77,158 @@ func ValidateSensor(s *v1alpha1.Sensor) error {
 		if s.Spec.EventProtocol.Nats.Type == pc.Streaming && s.Spec.EventProtocol.Nats.ClusterId == "" {
 			return fmt.Errorf("cluster id must be specified when using nats streaming")
 		}
+		if s.Spec.EventProtocol.AckAfterProcessing && s.Spec.EventProtocol.Nats.Type != pc.Streaming {
+			return fmt.Errorf("events can only be acknowledged after processing when using nats streaming")
+		}
+		if s.Spec.EventProtocol.Nats.AckWait != "" {
+			if _, err := time.ParseDuration(s.Spec.EventProtocol.Nats.AckWait); err != nil {
+				return fmt.Errorf("failed to parse ack wait. err: %+v", err)
+			}
+		}
 	default:
 		return fmt.Errorf("unknown gateway type")
 	}
 	return nil
 }
 
+// validateSuspendPolicy validates the policy governing events received while the sensor is suspended
+func validateSuspendPolicy(policy *v1alpha1.SuspendPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	switch policy.Action {
+	case "", v1alpha1.SuspendActionBuffer, v1alpha1.SuspendActionDrop:
+	default:
+		return fmt.Errorf("unknown suspend action '%s'", policy.Action)
+	}
+	if policy.BufferLimit < 0 {
+		return fmt.Errorf("suspend buffer limit can't be negative")
+	}
+	return nil
+}
+
+// validateSequence validates the out of order policy and the duration of the sequence of event dependencies
+func validateSequence(sequence *v1alpha1.Sequence) error {
+	if sequence == nil {
+		return nil
+	}
+	switch sequence.OutOfOrderPolicy {
+	case "", v1alpha1.OutOfOrderIgnore, v1alpha1.OutOfOrderReset:
+	default:
+		return fmt.Errorf("unknown out of order policy '%s'", sequence.OutOfOrderPolicy)
+	}
+	if sequence.Within != "" {
+		within, err := time.ParseDuration(sequence.Within)
+		if err != nil {
+			return fmt.Errorf("failed to parse sequence duration. err: %+v", err)
+		}
+		if within <= 0 {
+			return fmt.Errorf("sequence duration must be positive")
+		}
+	}
+	return nil
+}
+
+// validateDeadLetterSink validates that the dead letter sink defines exactly one destination
+func validateDeadLetterSink(sink *v1alpha1.DeadLetterSink) error {
+	if sink == nil {
+		return nil
+	}
+	sinks := 0
+	if sink.Nats != nil {
+		sinks++
+		if sink.Nats.URL == "" || sink.Nats.Subject == "" {
+			return fmt.Errorf("dead letter nats sink must define url and subject")
+		}
+	}
+	if sink.Http != nil {
+		sinks++
+		if sink.Http.URL == "" {
+			return fmt.Errorf("dead letter http sink must define url")
+		}
+	}
+	if sink.ConfigMap != nil {
+		sinks++
+		if sink.ConfigMap.Name == "" {
+			return fmt.Errorf("dead letter configmap sink must define name")
+		}
+		if sink.ConfigMap.MaxEntries < 0 {
+			return fmt.Errorf("dead letter configmap sink max entries can't be negative")
+		}
+	}
+	if sink.File != nil {
+		sinks++
+		if sink.File.Path == "" {
+			return fmt.Errorf("dead letter file sink must define path")
+		}
+		if sink.File.MaxEntries < 0 {
+			return fmt.Errorf("dead letter file sink max entries can't be negative")
+		}
+	}
+	if sinks != 1 {
+		return fmt.Errorf("dead letter sink must define exactly one of nats, http, configMap or file")
+	}
+	return nil
+}
+
+// validateStateStore validates the store of the events of the event dependencies
+func validateStateStore(store *v1alpha1.StateStore) error {
+	if store == nil {
+		return nil
+	}
+	stores := 0
+	if store.ConfigMap != nil {
+		stores++
+		if store.ConfigMap.Name == "" {
+			return fmt.Errorf("configmap state store must define name")
+		}
+	}
+	if store.File != nil {
+		stores++
+		if store.File.Path == "" {
+			return fmt.Errorf("file state store must define path")
+		}
+	}
+	if store.Nats != nil {
+		stores++
+		if store.Nats.URL == "" || store.Nats.ClusterId == "" || store.Nats.ClientId == "" || store.Nats.Subject == "" {
+			return fmt.Errorf("nats state store must define url, clusterId, clientId and subject")
+		}
+	}
+	if stores != 1 {
+		return fmt.Errorf("state store must define exactly one of configMap, file or nats")
+	}
+	return nil
+}
+
+// validateScaling validates the partitioning of the events among the replicas of the sensor
+func validateScaling(s *v1alpha1.Sensor) error {
+	scaling := s.Spec.Scaling
+	if scaling == nil {
+		return nil
+	}
+	if scaling.Replicas < 1 {
+		return fmt.Errorf("sensor must have at least one replica")
+	}
+	if scaling.Replicas == 1 {
+		return nil
+	}
+	// the events to correlate must reach the same replica
+	if scaling.Key == "" {
+		return fmt.Errorf("key must be specified to partition the events among the replicas")
+	}
+	// events are only shared among the replicas over nats
+	if s.Spec.EventProtocol == nil || s.Spec.EventProtocol.Type != pc.NATS {
+		return fmt.Errorf("sensor with more than one replica must receive events over nats")
+	}
+	for _, dependency := range s.Spec.Dependencies {
+		if dependency.Absence != nil {
+			return fmt.Errorf("absence event dependency %s can't be resolved by more than one replica", dependency.Name)
+		}
+	}
+	return nil
+}
+
 func validateTriggers(triggers []v1alpha1.Trigger) error {
 	if len(triggers) < 1 {
 		return fmt.Errorf("no triggers found")
@@ -77,6 +242,63 @@ func validateTriggers(triggers []v1alpha1.Trigger) error {
 		if trigger.Resource == nil {
 			return fmt.Errorf("trigger '%s' does not contain an absolute action", trigger.Name)
 		}
+		if trigger.RateLimit != nil {
+			if err := validateRateLimit(trigger.RateLimit); err != nil {
+				return fmt.Errorf("trigger '%s' has invalid rate limit. err: %+v", trigger.Name, err)
+			}
+		}
+		if trigger.Debounce != nil {
+			period, err := time.ParseDuration(trigger.Debounce.Period)
+			if err != nil {
+				return fmt.Errorf("trigger '%s' has invalid debounce period. err: %+v", trigger.Name, err)
+			}
+			if period <= 0 {
+				return fmt.Errorf("trigger '%s' must have a positive debounce period", trigger.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateFanOuts validates the fan-outs of triggers against the event dependencies
+func validateFanOuts(triggers []v1alpha1.Trigger, dependencies []v1alpha1.EventDependency) error {
+	for _, trigger := range triggers {
+		fanOut := trigger.FanOut
+		if fanOut == nil {
+			continue
+		}
+		found := false
+		for _, dependency := range dependencies {
+			if dependency.Name == fanOut.Event {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("trigger '%s' fans out over unknown event dependency '%s'", trigger.Name, fanOut.Event)
+		}
+		if fanOut.MaxItems < 0 {
+			return fmt.Errorf("trigger '%s' fan-out max items can't be negative", trigger.Name)
+		}
+		if fanOut.Parallelism < 0 {
+			return fmt.Errorf("trigger '%s' fan-out parallelism can't be negative", trigger.Name)
+		}
+	}
+	return nil
+}
+
+// validateRateLimit validates the rate limit of a trigger
+func validateRateLimit(rateLimit *v1alpha1.RateLimit) error {
+	switch rateLimit.Unit {
+	case "", v1alpha1.RateLimitUnitSecond, v1alpha1.RateLimitUnitMinute, v1alpha1.RateLimitUnitHour:
+	default:
+		return fmt.Errorf("unknown rate limit unit '%s'", rateLimit.Unit)
+	}
+	if rateLimit.RequestsPerUnit <= 0 {
+		return fmt.Errorf("requests per unit must be positive")
+	}
+	if rateLimit.Burst < 0 {
+		return fmt.Errorf("burst can't be negative")
 	}
 	return nil
 }
@@ -94,6 +316,127 @@ func validateSignals(eventDependencies []v1alpha1.EventDependency) error {
 		if err := validateEventFilter(ed.Filters); err != nil {
 			return err
 		}
+		if ed.Batch != nil {
+			if err := validateBatch(ed.Batch); err != nil {
+				return fmt.Errorf("event dependency '%s' has invalid batch. err: %+v", ed.Name, err)
+			}
+		}
+		if ed.Aggregation != nil {
+			if ed.Batch != nil {
+				return fmt.Errorf("event dependency '%s' can't define both batch and aggregation", ed.Name)
+			}
+			if err := validateAggregation(ed.Aggregation); err != nil {
+				return fmt.Errorf("event dependency '%s' has invalid aggregation. err: %+v", ed.Name, err)
+			}
+		}
+		if ed.Absence != nil {
+			if ed.Batch != nil || ed.Aggregation != nil {
+				return fmt.Errorf("event dependency '%s' can't define absence together with batch or aggregation", ed.Name)
+			}
+			if err := validateAbsence(ed.Absence); err != nil {
+				return fmt.Errorf("event dependency '%s' has invalid absence. err: %+v", ed.Name, err)
+			}
+		}
+		if err := validateEnrichments(ed.Enrichments); err != nil {
+			return fmt.Errorf("event dependency '%s' has invalid enrichments. err: %+v", ed.Name, err)
+		}
+	}
+	return nil
+}
+
+// validateEnrichments validates the enrichments of an event dependency
+func validateEnrichments(enrichments []v1alpha1.Enrichment) error {
+	names := make(map[string]bool)
+	for _, enrichment := range enrichments {
+		if enrichment.Name == "" {
+			return fmt.Errorf("enrichment must define a name")
+		}
+		if names[enrichment.Name] {
+			return fmt.Errorf("enrichment '%s' is defined more than once", enrichment.Name)
+		}
+		names[enrichment.Name] = true
+
+		lookups := 0
+		if enrichment.Resource != nil {
+			lookups++
+		}
+		if enrichment.HTTP != nil {
+			lookups++
+		}
+		if enrichment.ConfigMap != nil {
+			lookups++
+		}
+		if lookups != 1 {
+			return fmt.Errorf("enrichment '%s' must define exactly one of resource, http or configMap", enrichment.Name)
+		}
+	}
+	return nil
+}
+
+// validateAbsence validates the deadline of an absence event dependency
+func validateAbsence(absence *v1alpha1.Absence) error {
+	if (absence.Duration == "") == (absence.Schedule == "") {
+		return fmt.Errorf("exactly one of duration or schedule must be specified")
+	}
+	if absence.Duration != "" {
+		duration, err := time.ParseDuration(absence.Duration)
+		if err != nil {
+			return err
+		}
+		if duration <= 0 {
+			return fmt.Errorf("duration must be positive")
+		}
+		return nil
+	}
+	_, err := common.ResolveSchedule(absence.Schedule, "")
+	return err
+}
+
+// validateAggregation validates the aggregation of events of an event dependency
+func validateAggregation(aggregation *v1alpha1.Aggregation) error {
+	switch aggregation.Function {
+	case v1alpha1.AggregationCount:
+	case v1alpha1.AggregationSum, v1alpha1.AggregationAverage:
+		if aggregation.Path == "" {
+			return fmt.Errorf("path must be specified for %s", aggregation.Function)
+		}
+	default:
+		return fmt.Errorf("unknown aggregation function '%s'", aggregation.Function)
+	}
+	switch aggregation.Operator {
+	case "", v1alpha1.GreaterThan, v1alpha1.GreaterThanOrEqualTo, v1alpha1.LessThan, v1alpha1.LessThanOrEqualTo, v1alpha1.EqualTo:
+	default:
+		return fmt.Errorf("unknown comparison operator '%s'", aggregation.Operator)
+	}
+	window, err := time.ParseDuration(aggregation.Window)
+	if err != nil {
+		return err
+	}
+	if window <= 0 {
+		return fmt.Errorf("window must be positive")
+	}
+	if _, err := strconv.ParseFloat(aggregation.Threshold, 64); err != nil {
+		return fmt.Errorf("threshold must be a number. err: %+v", err)
+	}
+	return nil
+}
+
+// validateBatch validates the batching of events of an event dependency
+func validateBatch(batch *v1alpha1.Batch) error {
+	if batch.Window == "" && batch.MaxEvents == 0 {
+		return fmt.Errorf("either window or max events must be specified")
+	}
+	if batch.Window != "" {
+		window, err := time.ParseDuration(batch.Window)
+		if err != nil {
+			return err
+		}
+		if window <= 0 {
+			return fmt.Errorf("window must be positive")
+		}
+	}
+	if batch.MaxEvents < 0 {
+		return fmt.Errorf("max events can't be negative")
 	}
 	return nil
 }
@@ -19,6 +19,8 @@ package sensor
 import (
 	"testing"
 
+	pc "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/smartystreets/goconvey/convey"
 )
 
@@ -30,5 +32,234 @@ func TestValidateSensor(t *testing.T) {
 			err := ValidateSensor(sensor)
 			convey.So(err, convey.ShouldBeNil)
 		})
+
+		convey.Convey("Validate suspend policy", func() {
+			sensor.Spec.SuspendPolicy = &v1alpha1.SuspendPolicy{
+				Action:      v1alpha1.SuspendActionBuffer,
+				BufferLimit: 10,
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.SuspendPolicy.Action = "Pause"
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+
+			sensor.Spec.SuspendPolicy.Action = v1alpha1.SuspendActionDrop
+			sensor.Spec.SuspendPolicy.BufferLimit = -1
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Validate event dependency batch", func() {
+			sensor.Spec.Dependencies[0].Batch = &v1alpha1.Batch{
+				Window:    "5m",
+				MaxEvents: 100,
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.Dependencies[0].Batch = &v1alpha1.Batch{}
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+
+			sensor.Spec.Dependencies[0].Batch = &v1alpha1.Batch{
+				Window: "-5m",
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Validate event dependency aggregation", func() {
+			sensor.Spec.Dependencies[0].Aggregation = &v1alpha1.Aggregation{
+				Function:  v1alpha1.AggregationSum,
+				Path:      "bytes",
+				Window:    "1h",
+				Operator:  v1alpha1.GreaterThan,
+				Threshold: "1e9",
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.Dependencies[0].Aggregation.Path = ""
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+
+			sensor.Spec.Dependencies[0].Aggregation.Function = v1alpha1.AggregationCount
+			sensor.Spec.Dependencies[0].Aggregation.Threshold = "five"
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Validate event dependency absence", func() {
+			sensor.Spec.Dependencies[0].Absence = &v1alpha1.Absence{
+				Schedule: "0 3 * * *",
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.Dependencies[0].Absence.Duration = "30m"
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+
+			sensor.Spec.Dependencies[0].Absence.Schedule = ""
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.Dependencies[0].Absence.Duration = "-30m"
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Validate sequence", func() {
+			sensor.Spec.Sequence = &v1alpha1.Sequence{
+				Within:           "15m",
+				OutOfOrderPolicy: v1alpha1.OutOfOrderReset,
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.Sequence.OutOfOrderPolicy = "Restart"
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+
+			sensor.Spec.Sequence.OutOfOrderPolicy = ""
+			sensor.Spec.Sequence.Within = "15"
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Validate trigger fan-out", func() {
+			sensor.Spec.Triggers[0].FanOut = &v1alpha1.FanOut{
+				Event:       sensor.Spec.Dependencies[0].Name,
+				Path:        "commits",
+				Parallelism: 5,
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.Triggers[0].FanOut.MaxItems = -1
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+
+			sensor.Spec.Triggers[0].FanOut.MaxItems = 0
+			sensor.Spec.Triggers[0].FanOut.Event = "unknown"
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Validate event dependency enrichments", func() {
+			sensor.Spec.Dependencies[0].Enrichments = []v1alpha1.Enrichment{
+				{
+					Name: "owner",
+					ConfigMap: &v1alpha1.ConfigMapLookup{
+						Name: "owners",
+					},
+				},
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.Dependencies[0].Enrichments[0].HTTP = &v1alpha1.HTTPLookup{
+				URL: "http://owners.svc",
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+
+			sensor.Spec.Dependencies[0].Enrichments[0].ConfigMap = nil
+			sensor.Spec.Dependencies[0].Enrichments = append(sensor.Spec.Dependencies[0].Enrichments, sensor.Spec.Dependencies[0].Enrichments[0])
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Validate dead letter sink", func() {
+			sensor.Spec.DeadLetter = &v1alpha1.DeadLetterSink{
+				ConfigMap: &v1alpha1.ConfigMapSink{
+					Name:       "dead-letters",
+					MaxEntries: 10,
+				},
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.DeadLetter.Http = &v1alpha1.HttpSink{
+				URL: "http://dead-letters.svc",
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+
+			sensor.Spec.DeadLetter.ConfigMap = nil
+			sensor.Spec.DeadLetter.Http = nil
+			sensor.Spec.DeadLetter.Nats = &v1alpha1.NatsSink{
+				URL: "nats://nats.svc:4222",
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Validate scaling", func() {
+			sensor.Spec.Scaling = &v1alpha1.Scaling{
+				Replicas: 3,
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+
+			sensor.Spec.EventProtocol = &v1alpha1.EventProtocol{
+				Type: pc.NATS,
+				Nats: v1alpha1.Nats{
+					URL:  "nats://nats.argo-events:4222",
+					Type: pc.Standard,
+				},
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+
+			sensor.Spec.Scaling.Key = "order.id"
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.Dependencies[0].Absence = &v1alpha1.Absence{
+				Duration: "1h",
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Validate acknowledgement after processing", func() {
+			sensor.Spec.EventProtocol.AckAfterProcessing = true
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.EventProtocol = &v1alpha1.EventProtocol{
+				Type: pc.NATS,
+				Nats: v1alpha1.Nats{
+					URL:  "nats://nats.argo-events:4222",
+					Type: pc.Standard,
+				},
+				AckAfterProcessing: true,
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+
+			sensor.Spec.EventProtocol.Nats.Type = pc.Streaming
+			sensor.Spec.EventProtocol.Nats.ClusterId = "example-stan"
+			sensor.Spec.EventProtocol.Nats.ClientId = "webhook-sensor"
+			sensor.Spec.EventProtocol.Nats.AckWait = "30s"
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.EventProtocol.Nats.AckWait = "thirty seconds"
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Validate state store", func() {
+			sensor.Spec.StateStore = &v1alpha1.StateStore{
+				File: &v1alpha1.FileStateStore{
+					Path: "/var/lib/sensor",
+				},
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.StateStore.ConfigMap = &v1alpha1.ConfigMapStateStore{
+				Name: "sensor-state",
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+
+			sensor.Spec.StateStore.File = nil
+			sensor.Spec.StateStore.ConfigMap = nil
+			sensor.Spec.StateStore.Nats = &v1alpha1.NatsStateStore{
+				URL:       "nats://nats.svc:4222",
+				ClusterId: "example-stan",
+				Subject:   "sensor-state",
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Validate trigger rate limit and debounce", func() {
+			sensor.Spec.Triggers[0].RateLimit = &v1alpha1.RateLimit{
+				Unit:            v1alpha1.RateLimitUnitMinute,
+				RequestsPerUnit: 10,
+				Burst:           2,
+			}
+			sensor.Spec.Triggers[0].Debounce = &v1alpha1.Debounce{
+				Period: "30s",
+			}
+			convey.So(ValidateSensor(sensor), convey.ShouldBeNil)
+
+			sensor.Spec.Triggers[0].RateLimit.RequestsPerUnit = 0
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+
+			sensor.Spec.Triggers[0].RateLimit.RequestsPerUnit = 10
+			sensor.Spec.Triggers[0].Debounce.Period = "thirty seconds"
+			convey.So(ValidateSensor(sensor), convey.ShouldNotBeNil)
+		})
 	})
 }
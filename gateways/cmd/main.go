@@ -32,25 +32,27 @@ func main() {
 	// initialize gateway configuration
 	gc := gateways.NewGatewayConfiguration()
 
-	serverPort, ok := os.LookupEnv(common.EnvVarGatewayServerPort)
-	if !ok {
-		panic("gateway server port is not provided")
-	}
+	// check if gateway server is running. The event sources wait for a remote gateway server to be reachable instead.
+	if !gc.HasRemoteServer() {
+		serverPort, ok := os.LookupEnv(common.EnvVarGatewayServerPort)
+		if !ok {
+			panic("gateway server port is not provided")
+		}
 
-	// check if gateway server is running
-	if err := wait.ExponentialBackoff(wait.Backoff{
-		Duration: 1 * time.Second,
-		Factor:   2.0,
-		Jitter:   0.1,
-		Steps:    30,
-	}, func() (bool, error) {
-		_, err := net.Dial("tcp", fmt.Sprintf("localhost:%s", serverPort))
-		if err != nil {
-			return false, err
+		if err := wait.ExponentialBackoff(wait.Backoff{
+			Duration: 1 * time.Second,
+			Factor:   2.0,
+			Jitter:   0.1,
+			Steps:    30,
+		}, func() (bool, error) {
+			_, err := net.Dial("tcp", fmt.Sprintf("localhost:%s", serverPort))
+			if err != nil {
+				return false, err
+			}
+			return true, nil
+		}); err != nil {
+			panic(fmt.Errorf("failed to connect to server on port %s", serverPort))
 		}
-		return true, nil
-	}); err != nil {
-		panic(fmt.Errorf("failed to connect to server on port %s", serverPort))
 	}
 
 	// handle event source's status updates
@@ -60,6 +62,9 @@ func main() {
 		}
 	}()
 
+	// serve the delivery metrics of the gateway
+	go gc.ServeMetrics()
+
 	// watch updates to gateway resource
 	if _, err := gc.WatchGateway(context.Background()); err != nil {
 		panic(err)
@@ -19,13 +19,16 @@ package gateways
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"sync"
 
 	"github.com/nats-io/go-nats"
 
 	"github.com/argoproj/argo-events/common"
 	pc "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
+	sv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	gwclientset "github.com/argoproj/argo-events/pkg/client/gateway/clientset/versioned"
 	snats "github.com/nats-io/go-nats-streaming"
 	"github.com/rs/zerolog"
@@ -53,7 +56,7 @@ type GatewayConfig struct {
 	gwcs gwclientset.Interface
 	// updated indicates whether gateway resource is updated
 	updated bool
-	// serverPort is gateway server port to listen events from
+	// serverPort is gateway server port to listen events from, when the gateway server runs in the gateway pod
 	serverPort string
 	// registeredConfigs stores information about current event sources that are running in the gateway
 	registeredConfigs map[string]*EventSourceContext
@@ -69,6 +72,20 @@ type GatewayConfig struct {
 	natsStreamingConn snats.Conn
 	// sensorHttpPort is the http server running in sensor that listens to event. Only used if dispatch protocol is HTTP
 	sensorHttpPort string
+	// httpClient is the client shared by the dispatches of events to watchers over http
+	httpClient *http.Client
+	// retryQueue holds the deliveries to watchers that failed and are retried later
+	retryQueue *retryQueue
+	// deliveryLock protects the delivery status of the event sources
+	deliveryLock sync.Mutex
+	// deliveries holds the delivery status of the event sources not yet reported in the gateway resource
+	deliveries map[string]*eventSourceDelivery
+	// outbox persists the events until they are dispatched. nil if the gateway has no outbox
+	outbox *outbox
+	// optionsLock protects the options of the event sources
+	optionsLock sync.RWMutex
+	// eventSourceOptions holds the options of the running event sources, keyed by event source name
+	eventSourceOptions map[string]*eventSourceOptions
 }
 
 // EventSourceContext contains information of a event source for gateway to run.
@@ -95,6 +112,15 @@ type EventSourceData struct {
 	Config string `json:"config"`
 }
 
+// eventSourceOptions are the options of an event source applied by the gateway client to its events.
+// They are set in the configuration of the event source in the gateway configmap, and ignored by the gateway server.
+type eventSourceOptions struct {
+	// Transforms transform the events before they are dispatched, in order
+	Transforms []PayloadTransform `json:"transforms,omitempty"`
+	// Filters drop the transformed events that don't pass them instead of dispatching them
+	Filters *sv1alpha1.EventDependencyFilter `json:"filters,omitempty"`
+}
+
 // GatewayEvent is the internal representation of an event.
 type GatewayEvent struct {
 	// Src is source of event
@@ -126,11 +152,6 @@ func NewGatewayConfiguration() *GatewayConfig {
 	if !ok {
 		panic("gateway controller instance ID is not provided")
 	}
-	serverPort, ok := os.LookupEnv(common.EnvVarGatewayServerPort)
-	if !ok {
-		panic("server port is not provided")
-	}
-
 	clientset := kubernetes.NewForConfigOrDie(restConfig)
 	gwcs := gwclientset.NewForConfigOrDie(restConfig)
 	gw, err := gwcs.ArgoprojV1alpha1().Gateways(namespace).Get(name, metav1.GetOptions{})
@@ -138,6 +159,12 @@ func NewGatewayConfiguration() *GatewayConfig {
 		panic(err)
 	}
 
+	// the server port is only needed to connect to the gateway server of the gateway pod
+	serverPort, ok := os.LookupEnv(common.EnvVarGatewayServerPort)
+	if !ok && gw.Spec.Server == nil {
+		panic("server port is not provided")
+	}
+
 	gc := &GatewayConfig{
 		Log:                  common.GetLoggerContext(common.LoggerConf()).Str("gateway-name", name).Str("gateway-namespace", namespace).Logger(),
 		Clientset:            clientset,
@@ -151,11 +178,16 @@ func NewGatewayConfiguration() *GatewayConfig {
 		controllerInstanceID: controllerInstanceID,
 		serverPort:           serverPort,
 		StatusCh:             make(chan EventSourceStatus),
+		deliveries:           make(map[string]*eventSourceDelivery),
+		eventSourceOptions:   make(map[string]*eventSourceOptions),
 	}
 
 	switch gw.Spec.EventProtocol.Type {
 	case pc.HTTP:
 		gc.sensorHttpPort = gw.Spec.EventProtocol.Http.Port
+		gc.httpClient = newDispatchClient()
+		gc.retryQueue = newRetryQueue(retryQueueSize)
+		go gc.processRetryQueue()
 	case pc.NATS:
 		if gc.natsConn, err = nats.Connect(gw.Spec.EventProtocol.Nats.URL); err != nil {
 			panic(fmt.Errorf("failed to obtain NATS standard connection. err: %+v", err))
@@ -170,5 +202,13 @@ func NewGatewayConfiguration() *GatewayConfig {
 			gc.Log.Info().Str("nats-url", gw.Spec.EventProtocol.Nats.URL).Msg("nats streaming connection successful")
 		}
 	}
+
+	if gw.Spec.Outbox != nil {
+		if gc.outbox, err = newOutbox(gw.Spec.Outbox); err != nil {
+			panic(err)
+		}
+	}
+
+	go gc.reportDeliveries()
 	return gc
 }
@@ -48,24 +48,11 @@ func (ese *CalendarEventSourceExecutor) StartEventSource(eventSource *gateways.E
 }
 
 func resolveSchedule(cal *calSchedule) (cronlib.Schedule, error) {
-	if cal.Schedule != "" {
-		// standard cron expression
-		specParser := cronlib.NewParser(cronlib.Minute | cronlib.Hour | cronlib.Dom | cronlib.Month | cronlib.Dow)
-		schedule, err := specParser.Parse(cal.Schedule)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse schedule %s from calendar event. Cause: %+v", cal.Schedule, err.Error())
-		}
-		return schedule, nil
-	} else if cal.Interval != "" {
-		intervalDuration, err := time.ParseDuration(cal.Interval)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse interval %s from calendar event. Cause: %+v", cal.Interval, err.Error())
-		}
-		schedule := cronlib.ConstantDelaySchedule{Delay: intervalDuration}
-		return schedule, nil
-	} else {
-		return nil, fmt.Errorf("calendar event must contain either a schedule or interval")
+	schedule, err := common.ResolveSchedule(cal.Schedule, cal.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve schedule from calendar event. Cause: %+v", err)
 	}
+	return schedule, nil
 }
 
 // listenEvents fires an event when schedule is passed.
@@ -21,6 +21,9 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// prefetchCount is the number of messages delivered by the broker that wait to be dispatched by the gateway
+const prefetchCount = 10
+
 // AMQPEventSourceExecutor implements Eventing
 type AMQPEventSourceExecutor struct {
 	Log zerolog.Logger
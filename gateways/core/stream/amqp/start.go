@@ -18,11 +18,19 @@ package amqp
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/argoproj/argo-events/gateways"
 	amqplib "github.com/streadway/amqp"
 )
 
+const (
+	// minRedeliveryDelay is the delay before a message the gateway client failed to dispatch is requeued
+	minRedeliveryDelay = time.Second
+	// maxRedeliveryDelay is the maximum delay before a message is requeued, the delay doubling on each consecutive failure
+	maxRedeliveryDelay = time.Minute
+)
+
 // StartEventSource starts an event source
 func (ese *AMQPEventSourceExecutor) StartEventSource(eventSource *gateways.EventSource, eventStream gateways.Eventing_StartEventSourceServer) error {
 	ese.Log.Info().Str("event-stream-name", eventSource.Name).Msg("operating on event source")
@@ -31,13 +39,13 @@ func (ese *AMQPEventSourceExecutor) StartEventSource(eventSource *gateways.Event
 		return err
 	}
 
-	dataCh := make(chan []byte)
+	eventCh := make(chan *gateways.AckedEvent)
 	errorCh := make(chan error)
 	doneCh := make(chan struct{}, 1)
 
-	go ese.listenEvents(a, eventSource, dataCh, errorCh, doneCh)
+	go ese.listenEvents(a, eventSource, eventCh, errorCh, doneCh)
 
-	return gateways.HandleEventsFromEventSource(eventSource.Name, eventStream, dataCh, errorCh, doneCh, &ese.Log)
+	return gateways.HandleAckedEventsFromEventSource(eventSource.Name, eventStream, eventCh, errorCh, doneCh, &ese.Log)
 }
 
 func getDelivery(ch *amqplib.Channel, a *amqp) (<-chan amqplib.Delivery, error) {
@@ -46,6 +54,11 @@ func getDelivery(ch *amqplib.Channel, a *amqp) (<-chan amqplib.Delivery, error)
 		return nil, fmt.Errorf("failed to declare exchange with name %s and type %s. err: %+v", a.ExchangeName, a.ExchangeType, err)
 	}
 
+	// limit the messages delivered but not yet acknowledged, as messages are acknowledged once dispatched by the gateway
+	if err := ch.Qos(prefetchCount, 0, false); err != nil {
+		return nil, fmt.Errorf("failed to set prefetch count: %s", err)
+	}
+
 	q, err := ch.QueueDeclare("", false, false, true, false, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to declare queue: %s", err)
@@ -56,14 +69,14 @@ func getDelivery(ch *amqplib.Channel, a *amqp) (<-chan amqplib.Delivery, error)
 		return nil, fmt.Errorf("failed to bind %s exchange '%s' to queue with routingKey: %s: %s", a.ExchangeType, a.ExchangeName, a.RoutingKey, err)
 	}
 
-	delivery, err := ch.Consume(q.Name, "", true, false, false, false, nil)
+	delivery, err := ch.Consume(q.Name, "", false, false, false, false, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin consuming messages: %s", err)
 	}
 	return delivery, nil
 }
 
-func (ese *AMQPEventSourceExecutor) listenEvents(a *amqp, eventSource *gateways.EventSource, dataCh chan []byte, errorCh chan error, doneCh chan struct{}) {
+func (ese *AMQPEventSourceExecutor) listenEvents(a *amqp, eventSource *gateways.EventSource, eventCh chan *gateways.AckedEvent, errorCh chan error, doneCh chan struct{}) {
 	defer gateways.Recover(eventSource.Name)
 
 	conn, err := amqplib.Dial(a.URL)
@@ -71,6 +84,11 @@ func (ese *AMQPEventSourceExecutor) listenEvents(a *amqp, eventSource *gateways.
 		errorCh <- err
 		return
 	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			ese.Log.Error().Err(err).Str("event-stream-name", eventSource.Name).Msg("failed to close connection")
+		}
+	}()
 
 	ch, err := conn.Channel()
 	if err != nil {
@@ -85,15 +103,59 @@ func (ese *AMQPEventSourceExecutor) listenEvents(a *amqp, eventSource *gateways.
 	}
 
 	ese.Log.Info().Str("event-source-name", eventSource.Name).Msg("starting to subscribe to messages")
+	redeliveryDelay := minRedeliveryDelay
 	for {
 		select {
 		case msg := <-delivery:
-			dataCh <- msg.Body
-		case <-doneCh:
-			err = conn.Close()
-			if err != nil {
-				ese.Log.Error().Err(err).Str("event-stream-name", eventSource.Name).Msg("failed to close connection")
+			event := gateways.NewAckedEvent(&gateways.Event{
+				Payload:     msg.Body,
+				ContentType: msg.ContentType,
+				Time:        gateways.EventTime(msg.Timestamp),
+				Attributes: map[string]string{
+					"amqpexchange":   msg.Exchange,
+					"amqproutingkey": msg.RoutingKey,
+					"amqpmessageid":  msg.MessageId,
+				},
+			})
+			select {
+			case eventCh <- event:
+			case <-doneCh:
+				return
+			}
+			// the message is acknowledged once the event is dispatched, otherwise it is requeued
+			if err := <-event.Ack; err != nil {
+				if _, ok := err.(*gateways.DispatchError); !ok {
+					// the stream to the gateway client is closed
+					if nackErr := msg.Nack(false, true); nackErr != nil {
+						ese.Log.Error().Err(nackErr).Str("event-stream-name", eventSource.Name).Msg("failed to requeue message")
+					}
+					return
+				}
+				// the message is requeued after a delay, so a message the watchers keep failing doesn't bounce back at once
+				ese.Log.Info().Str("event-stream-name", eventSource.Name).Str("delay", redeliveryDelay.String()).Msg("requeueing message after delay")
+				timer := time.NewTimer(redeliveryDelay)
+				select {
+				case <-timer.C:
+				case <-doneCh:
+					timer.Stop()
+					if nackErr := msg.Nack(false, true); nackErr != nil {
+						ese.Log.Error().Err(nackErr).Str("event-stream-name", eventSource.Name).Msg("failed to requeue message")
+					}
+					return
+				}
+				if nackErr := msg.Nack(false, true); nackErr != nil {
+					ese.Log.Error().Err(nackErr).Str("event-stream-name", eventSource.Name).Msg("failed to requeue message")
+				}
+				if redeliveryDelay *= 2; redeliveryDelay > maxRedeliveryDelay {
+					redeliveryDelay = maxRedeliveryDelay
+				}
+				continue
 			}
+			redeliveryDelay = minRedeliveryDelay
+			if err := msg.Ack(false); err != nil {
+				ese.Log.Error().Err(err).Str("event-stream-name", eventSource.Name).Msg("failed to acknowledge message")
+			}
+		case <-doneCh:
 			return
 		}
 	}
@@ -35,6 +35,10 @@ type kafka struct {
 	Partition string `json:"partition"`
 	// Topic name
 	Topic string `json:"topic"`
+	// ConsumerGroup is the consumer group the offsets of the messages are committed for, once the events are dispatched by the gateway.
+	// The event source resumes from the committed offset when it restarts. Without a consumer group, the event source consumes the newest messages.
+	// +optional
+	ConsumerGroup string `json:"consumerGroup,omitempty"`
 }
 
 func parseEventSource(eventSource string) (*kafka, error) {
@@ -41,19 +41,26 @@ func (ese *KafkaEventSourceExecutor) StartEventSource(eventSource *gateways.Even
 		return err
 	}
 
-	dataCh := make(chan []byte)
+	eventCh := make(chan *gateways.AckedEvent)
 	errorCh := make(chan error)
 	doneCh := make(chan struct{}, 1)
 
-	go ese.listenEvents(k, eventSource, dataCh, errorCh, doneCh)
+	go ese.listenEvents(k, eventSource, eventCh, errorCh, doneCh)
 
-	return gateways.HandleEventsFromEventSource(eventSource.Name, eventStream, dataCh, errorCh, doneCh, &ese.Log)
+	return gateways.HandleAckedEventsFromEventSource(eventSource.Name, eventStream, eventCh, errorCh, doneCh, &ese.Log)
 }
 
-func (ese *KafkaEventSourceExecutor) listenEvents(k *kafka, eventSource *gateways.EventSource, dataCh chan []byte, errorCh chan error, doneCh chan struct{}) {
+func (ese *KafkaEventSourceExecutor) listenEvents(k *kafka, eventSource *gateways.EventSource, eventCh chan *gateways.AckedEvent, errorCh chan error, doneCh chan struct{}) {
 	defer gateways.Recover(eventSource.Name)
 
-	consumer, err := sarama.NewConsumer([]string{k.URL}, nil)
+	client, err := sarama.NewClient([]string{k.URL}, sarama.NewConfig())
+	if err != nil {
+		errorCh <- err
+		return
+	}
+	defer client.Close()
+
+	consumer, err := sarama.NewConsumerFromClient(client)
 	if err != nil {
 		errorCh <- err
 		return
@@ -76,27 +83,76 @@ func (ese *KafkaEventSourceExecutor) listenEvents(k *kafka, eventSource *gateway
 		return
 	}
 
-	partitionConsumer, err := consumer.ConsumePartition(k.Topic, partition, sarama.OffsetNewest)
+	// the offsets are committed for the consumer group once the events are dispatched
+	offset := sarama.OffsetNewest
+	var partitionOffsetManager sarama.PartitionOffsetManager
+	if k.ConsumerGroup != "" {
+		offsetManager, err := sarama.NewOffsetManagerFromClient(k.ConsumerGroup, client)
+		if err != nil {
+			errorCh <- err
+			return
+		}
+		defer offsetManager.Close()
+		if partitionOffsetManager, err = offsetManager.ManagePartition(k.Topic, partition); err != nil {
+			errorCh <- err
+			return
+		}
+		defer partitionOffsetManager.Close()
+		offset, _ = partitionOffsetManager.NextOffset()
+	}
+
+	partitionConsumer, err := consumer.ConsumePartition(k.Topic, partition, offset)
 	if err != nil {
 		errorCh <- err
 		return
 	}
+	defer func() {
+		if err := partitionConsumer.Close(); err != nil {
+			ese.Log.Error().Err(err).Str("event-source-name", eventSource.Name).Msg("failed to close consumer")
+		}
+	}()
 
 	ese.Log.Info().Str("event-source-name", eventSource.Name).Msg("starting to subscribe to messages")
 	for {
 		select {
 		case msg := <-partitionConsumer.Messages():
-			dataCh <- msg.Value
+			event := gateways.NewAckedEvent(&gateways.Event{
+				Payload: msg.Value,
+				Time:    gateways.EventTime(msg.Timestamp),
+				Attributes: map[string]string{
+					"kafkatopic":     msg.Topic,
+					"kafkapartition": strconv.FormatInt(int64(msg.Partition), 10),
+					"kafkaoffset":    strconv.FormatInt(msg.Offset, 10),
+					"kafkakey":       string(msg.Key),
+				},
+			})
+			select {
+			case eventCh <- event:
+			case <-doneCh:
+				return
+			}
+			// the next message is only consumed once the event is dispatched
+			if err := <-event.Ack; err != nil {
+				if _, ok := err.(*gateways.DispatchError); !ok {
+					// the stream to the gateway client is closed
+					return
+				}
+				if partitionOffsetManager != nil {
+					// the event source is restarted from the last committed offset
+					errorCh <- fmt.Errorf("failed to dispatch message at offset %d. err: %+v", msg.Offset, err)
+					return
+				}
+				continue
+			}
+			if partitionOffsetManager != nil {
+				partitionOffsetManager.MarkOffset(msg.Offset+1, "")
+			}
 
 		case err := <-partitionConsumer.Errors():
 			errorCh <- err
 			return
 
 		case <-doneCh:
-			err = partitionConsumer.Close()
-			if err != nil {
-				ese.Log.Error().Err(err).Str("event-source-name", eventSource.Name).Msg("failed to close consumer")
-			}
 			return
 		}
 	}
@@ -17,6 +17,8 @@ limitations under the License.
 package mqtt
 
 import (
+	"strconv"
+
 	"github.com/argoproj/argo-events/gateways"
 	MQTTlib "github.com/eclipse/paho.mqtt.golang"
 )
@@ -29,20 +31,26 @@ func (ese *MqttEventSourceExecutor) StartEventSource(eventSource *gateways.Event
 		return err
 	}
 
-	dataCh := make(chan []byte)
+	eventCh := make(chan *gateways.Event)
 	errorCh := make(chan error)
 	doneCh := make(chan struct{}, 1)
 
-	go ese.listenEvents(m, eventSource, dataCh, errorCh, doneCh)
+	go ese.listenEvents(m, eventSource, eventCh, errorCh, doneCh)
 
-	return gateways.HandleEventsFromEventSource(eventSource.Name, eventStream, dataCh, errorCh, doneCh, &ese.Log)
+	return gateways.HandleEventsWithMetadataFromEventSource(eventSource.Name, eventStream, eventCh, errorCh, doneCh, &ese.Log)
 }
 
-func (ese *MqttEventSourceExecutor) listenEvents(m *mqtt, eventSource *gateways.EventSource, dataCh chan []byte, errorCh chan error, doneCh chan struct{}) {
+func (ese *MqttEventSourceExecutor) listenEvents(m *mqtt, eventSource *gateways.EventSource, eventCh chan *gateways.Event, errorCh chan error, doneCh chan struct{}) {
 	defer gateways.Recover(eventSource.Name)
 
 	handler := func(c MQTTlib.Client, msg MQTTlib.Message) {
-		dataCh <- msg.Payload()
+		eventCh <- &gateways.Event{
+			Payload: msg.Payload(),
+			Attributes: map[string]string{
+				"mqtttopic":     msg.Topic(),
+				"mqttmessageid": strconv.Itoa(int(msg.MessageID())),
+			},
+		}
 	}
 	opts := MQTTlib.NewClientOptions().AddBroker(m.URL).SetClientID(m.ClientId)
 	client := MQTTlib.NewClient(opts)
@@ -38,6 +38,9 @@ type webhook struct {
 	Method string `json:"method" protobuf:"bytes,2,opt,name=method"`
 	// Port on which HTTP server is listening for incoming events.
 	Port string `json:"port" protobuf:"bytes,3,opt,name=port"`
+	// Headers are the names of the request headers passed along as the attributes of the event.
+	// Defaults to all the headers, except the headers carrying credentials, which are never passed along.
+	Headers []string `json:"headers,omitempty" protobuf:"bytes,4,rep,name=headers"`
 	// srv holds reference to http server
 	// +k8s:openapi-gen=false
 	srv *http.Server `json:"srv,omitempty"`
@@ -45,6 +48,17 @@ type webhook struct {
 	mux *http.ServeMux `json:"mux,omitempty"`
 }
 
+// credentialHeaders are the request headers carrying credentials, in lower case.
+// They are never passed along as they would reach every watcher of the gateway.
+var credentialHeaders = map[string]bool{
+	"authorization":       true,
+	"proxy-authorization": true,
+	"cookie":              true,
+	"x-hub-signature":     true,
+	"x-gitlab-token":      true,
+	"x-api-key":           true,
+}
+
 func parseEventSource(es string) (*webhook, error) {
 	var n *webhook
 	err := yaml.Unmarshal([]byte(es), &n)
@@ -21,6 +21,7 @@ import (
 	"github.com/argoproj/argo-events/common"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/argoproj/argo-events/gateways"
@@ -61,8 +62,8 @@ type routeConfig struct {
 }
 
 type endpoint struct {
-	active bool
-	dataCh chan []byte
+	active  bool
+	eventCh chan *gateways.Event
 }
 
 func init() {
@@ -144,12 +145,34 @@ func (rc *routeConfig) routeActiveHandler(writer http.ResponseWriter, request *h
 		return
 	}
 
-	activeEndpoints[rc.wConfig.Endpoint].dataCh <- body
+	activeEndpoints[rc.wConfig.Endpoint].eventCh <- &gateways.Event{
+		Payload:     body,
+		ContentType: request.Header.Get("Content-Type"),
+		Attributes:  eventAttributes(request.Header, rc.wConfig.Headers),
+	}
 	response = "request successfully processed"
 	rc.eventSourceExecutor.Log.Info().Str("endpoint", rc.wConfig.Endpoint).Str("http-method", request.Method).Str("response", response).Msg("request payload parsed successfully")
 	common.SendSuccessResponse(writer, response)
 }
 
+// eventAttributes returns the headers of the request passed along as the attributes of the event, keyed by their names in lower case.
+// Only the listed headers are passed along if any, and never the headers carrying credentials.
+func eventAttributes(header http.Header, names []string) map[string]string {
+	allowed := make(map[string]bool, len(names))
+	for _, name := range names {
+		allowed[strings.ToLower(name)] = true
+	}
+	attributes := make(map[string]string, len(header))
+	for name, values := range header {
+		name = strings.ToLower(name)
+		if credentialHeaders[name] || (len(allowed) > 0 && !allowed[name]) {
+			continue
+		}
+		attributes[name] = strings.Join(values, ",")
+	}
+	return attributes
+}
+
 // StartEventSource starts a event source
 func (ese *WebhookEventSourceExecutor) StartEventSource(eventSource *gateways.EventSource, eventStream gateways.Eventing_StartEventSourceServer) error {
 	defer gateways.Recover(eventSource.Name)
@@ -180,8 +203,8 @@ func (ese *WebhookEventSourceExecutor) StartEventSource(eventSource *gateways.Ev
 	ese.Log.Info().Str("event-source-name", eventSource.Name).Str("port", h.Port).Str("endpoint", h.Endpoint).Str("method", h.Method).Msg("adding route handler")
 	if _, ok := activeEndpoints[rc.wConfig.Endpoint]; !ok {
 		activeEndpoints[rc.wConfig.Endpoint] = &endpoint{
-			active: true,
-			dataCh: make(chan []byte),
+			active:  true,
+			eventCh: make(chan *gateways.Event),
 		}
 		rc.wConfig.mux.HandleFunc(rc.wConfig.Endpoint, rc.routeActiveHandler)
 	}
@@ -190,12 +213,10 @@ func (ese *WebhookEventSourceExecutor) StartEventSource(eventSource *gateways.Ev
 	ese.Log.Info().Str("event-source-name", eventSource.Name).Str("port", h.Port).Str("endpoint", h.Endpoint).Str("method", h.Method).Msg("route handler added")
 	for {
 		select {
-		case data := <-activeEndpoints[rc.wConfig.Endpoint].dataCh:
+		case event := <-activeEndpoints[rc.wConfig.Endpoint].eventCh:
 			ese.Log.Info().Str("event-source-name", eventSource.Name).Msg("new event received, dispatching to gateway client")
-			err := eventStream.Send(&gateways.Event{
-				Name:    eventSource.Name,
-				Payload: data,
-			})
+			event.Name = eventSource.Name
+			err := eventStream.Send(event)
 			if err != nil {
 				ese.Log.Error().Err(err).Str("event-source-name", eventSource.Name).Msg("failed to send event")
 				return err
@@ -76,5 +76,14 @@ func validateWebhook(w *webhook) error {
 			return fmt.Errorf("failed to parse server port %s. err: %+v", w.Port, err)
 		}
 	}
+
+	for _, name := range w.Headers {
+		if name == "" {
+			return fmt.Errorf("header name can't be empty")
+		}
+		if credentialHeaders[strings.ToLower(name)] {
+			return fmt.Errorf("header %s carries credentials and can't be passed along", name)
+		}
+	}
 	return nil
 }
@@ -19,6 +19,7 @@ package webhook
 import (
 	"context"
 	"github.com/smartystreets/goconvey/convey"
+	"net/http"
 	"testing"
 
 	"github.com/argoproj/argo-events/gateways"
@@ -61,4 +62,37 @@ port: "10000"
 		convey.So(valid.IsValid, convey.ShouldBeFalse)
 		convey.So(valid.Reason, convey.ShouldNotBeEmpty)
 	})
+
+	convey.Convey("Given a webhook event source passing along a header carrying credentials, make sure error occurs", t, func() {
+		ese := &WebhookEventSourceExecutor{}
+		valid, _ := ese.ValidateEventSource(context.Background(), &gateways.EventSource{
+			Data: configValue + `
+headers:
+  - X-GitHub-Event
+  - Authorization
+`,
+			Id:   configId,
+			Name: configKey,
+		})
+		convey.So(valid, convey.ShouldNotBeNil)
+		convey.So(valid.IsValid, convey.ShouldBeFalse)
+		convey.So(valid.Reason, convey.ShouldContainSubstring, "Authorization")
+	})
+
+	convey.Convey("Given the headers of a request, make sure only the allowed headers without credentials are passed along", t, func() {
+		header := http.Header{}
+		header.Set("X-GitHub-Event", "push")
+		header.Set("Authorization", "Bearer token")
+		header.Set("Cookie", "session=1")
+		header.Set("X-Hub-Signature", "sha1=abc")
+		header.Set("User-Agent", "GitHub-Hookshot")
+
+		convey.So(eventAttributes(header, nil), convey.ShouldResemble, map[string]string{
+			"x-github-event": "push",
+			"user-agent":     "GitHub-Hookshot",
+		})
+		convey.So(eventAttributes(header, []string{"X-GitHub-Event"}), convey.ShouldResemble, map[string]string{
+			"x-github-event": "push",
+		})
+	})
 }
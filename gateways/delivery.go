@@ -0,0 +1,512 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateways
+
+import (
+	"container/heap"
+	"expvar"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// dispatchTimeout is the timeout of a http request dispatching an event to a watcher
+	dispatchTimeout = 10 * time.Second
+	// retryQueueSize is the maximum number of failed deliveries waiting to be retried
+	retryQueueSize = 1000
+	// retryQueueDelay is the delay before a failed delivery is retried, multiplied by the number of attempts
+	retryQueueDelay = 10 * time.Second
+	// maxDeliveryAttempts is the number of times a failed delivery is retried from the retry queue before it is dropped
+	maxDeliveryAttempts = 5
+	// deliveryReportInterval is the interval at which the delivery status of the event sources is reported in the gateway resource
+	deliveryReportInterval = 30 * time.Second
+)
+
+// dispatchBackoff is the backoff of the retries of a delivery to a watcher, before the delivery is queued for a later retry
+var dispatchBackoff = wait.Backoff{
+	Steps:    3,
+	Duration: 200 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
+// delivery metrics, keyed by watcher, served on the metrics endpoint of the gateway client
+var (
+	deliveredEvents   = expvar.NewMap("gateway_delivered_events")
+	failedDeliveries  = expvar.NewMap("gateway_failed_deliveries")
+	retriedDeliveries = expvar.NewMap("gateway_retried_deliveries")
+	droppedDeliveries = expvar.NewMap("gateway_dropped_deliveries")
+	filteredEvents    = expvar.NewMap("gateway_filtered_events")
+	pendingRetries    = expvar.NewInt("gateway_pending_retries")
+)
+
+// watcher is a component receiving the events of the gateway over http
+type watcher struct {
+	// name identifies the watcher in logs and metrics
+	name string
+	// url is the endpoint the events are posted to
+	url string
+	// routing selects the events posted to the watcher, nil for all the events
+	routing *v1alpha1.WatcherRouting
+}
+
+// accepts returns true if the event of the event source is routed to the watcher
+func (w *watcher) accepts(eventSource string, event *apicommon.Event) (bool, error) {
+	if w.routing == nil {
+		return true, nil
+	}
+	if len(w.routing.EventSources) > 0 {
+		found := false
+		for _, name := range w.routing.EventSources {
+			if name == eventSource {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	if w.routing.Filter == nil {
+		return true, nil
+	}
+	return common.FilterEvent(*w.routing.Filter, event)
+}
+
+// pendingDelivery is a delivery to a watcher waiting in the retry queue
+type pendingDelivery struct {
+	// watcher to deliver the event to
+	watcher watcher
+	// header holds the headers of the request carrying the event
+	header http.Header
+	// payload is the event
+	payload []byte
+	// attempts is the number of failed attempts to deliver the event
+	attempts int
+	// retryAt is the time after which the delivery is retried
+	retryAt time.Time
+	// outbox tracks the delivery of the event of the outbox entry, nil if the event is not in the outbox
+	outbox *outboxDelivery
+	// settlement tracks the retries of the deliveries of an event that is not in the outbox, nil if the event is in the outbox
+	settlement *retrySettlement
+}
+
+// eventSourceDelivery holds the delivery outcomes of an event source not yet reported in the gateway resource
+type eventSourceDelivery struct {
+	// name of the event source
+	name string
+	// status holds the outcomes since the last report
+	status v1alpha1.DeliveryStatus
+}
+
+// watcherResponseError is the error returned when a watcher responds with a non 2xx status code
+type watcherResponseError struct {
+	statusCode int
+}
+
+func (e *watcherResponseError) Error() string {
+	return fmt.Sprintf("watcher responded with status code %d", e.statusCode)
+}
+
+// deliveryError is the error returned when the event failed to be delivered to some of the watchers
+type deliveryError struct {
+	// watchers holds the names of the watchers the event failed to be delivered to
+	watchers []string
+	// rejected is true if all the watchers the event failed to be delivered to rejected it
+	rejected bool
+	// settled receives the outcome of the retries of the failed deliveries of an event that is not in the outbox, once they are over.
+	// nil if the deliveries are not retried from the retry queue.
+	settled <-chan error
+}
+
+func (e *deliveryError) Error() string {
+	return fmt.Sprintf("failed to dispatch event to watchers %s", strings.Join(e.watchers, ", "))
+}
+
+// retriedError is the error returned when the event failed to be dispatched but is kept in the outbox to be dispatched again
+type retriedError struct {
+	err error
+}
+
+func (e *retriedError) Error() string {
+	return e.err.Error()
+}
+
+// ackError returns the error the event is acknowledged with to the event source, once the event is dispatched.
+// An event kept in the outbox, or rejected by the watchers, is acknowledged, as delivering it again is either taken care of
+// by the outbox or can't succeed. An event whose failed deliveries are retried from the retry queue is only acknowledged once
+// the retries are over, so it blocks until then, as the retry queue doesn't survive a restart of the gateway client.
+func ackError(err error) error {
+	switch e := err.(type) {
+	case *retriedError:
+		return nil
+	case *deliveryError:
+		if e.settled != nil {
+			return <-e.settled
+		}
+		if e.rejected {
+			return nil
+		}
+	}
+	return err
+}
+
+// retrySettlement tracks the retries of the failed deliveries of an event that is not in the outbox
+type retrySettlement struct {
+	// lock protects the state of the settlement, which is updated by the retries
+	lock sync.Mutex
+	// retrying is the number of deliveries of the event in the retry queue
+	retrying int
+	// failed holds the names of the watchers the retries failed to deliver the event to
+	failed []string
+	// settled receives the outcome of the retries once they are over
+	settled chan error
+}
+
+// newRetrySettlement returns the settlement of the retries of the deliveries
+func newRetrySettlement(retrying int) *retrySettlement {
+	return &retrySettlement{
+		retrying: retrying,
+		settled:  make(chan error, 1),
+	}
+}
+
+// done records the end of the retries of the delivery of the event to the watcher. The delivery failed if the retries were
+// exhausted, but not if the event was delivered or rejected.
+func (s *retrySettlement) done(watcher string, failed bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if failed {
+		s.failed = append(s.failed, watcher)
+	}
+	s.retrying--
+	if s.retrying > 0 {
+		return
+	}
+	if len(s.failed) > 0 {
+		s.settled <- &deliveryError{watchers: s.failed}
+		return
+	}
+	s.settled <- nil
+}
+
+// isRetryableDelivery returns false if the watcher rejected the event, in which case a retry can't succeed
+func isRetryableDelivery(err error) bool {
+	if responseErr, ok := err.(*watcherResponseError); ok {
+		switch {
+		case responseErr.statusCode == http.StatusRequestTimeout, responseErr.statusCode == http.StatusTooManyRequests:
+			return true
+		case responseErr.statusCode >= 400 && responseErr.statusCode < 500:
+			return false
+		}
+	}
+	return true
+}
+
+// newDispatchClient returns the http client used to dispatch events to watchers
+func newDispatchClient() *http.Client {
+	return &http.Client{
+		Timeout: dispatchTimeout,
+	}
+}
+
+// httpWatchers returns the watchers of the gateway that receive events over http
+func (gc *GatewayConfig) httpWatchers() []watcher {
+	var watchers []watcher
+	if gc.gw.Spec.Watchers == nil {
+		return watchers
+	}
+	for _, sensor := range gc.gw.Spec.Watchers.Sensors {
+		watchers = append(watchers, watcher{
+			name:    fmt.Sprintf("sensor/%s", sensor.Name),
+			url:     fmt.Sprintf("http://%s:%s%s", common.DefaultServiceName(sensor.Name), gc.gw.Spec.EventProtocol.Http.Port, common.SensorServiceEndpoint),
+			routing: sensor.Routing,
+		})
+	}
+	for _, gateway := range gc.gw.Spec.Watchers.Gateways {
+		watchers = append(watchers, watcher{
+			name:    fmt.Sprintf("gateway/%s", gateway.Name),
+			url:     fmt.Sprintf("http://%s:%s%s", common.DefaultServiceName(gateway.Name), gateway.Port, gateway.Endpoint),
+			routing: gateway.Routing,
+		})
+	}
+	return watchers
+}
+
+// routeEvent returns the watchers the event of the event source is routed to.
+// A watcher whose routing filter fails to apply to the event receives the event, leaving the decision to the watcher.
+func (gc *GatewayConfig) routeEvent(eventSource string, event *apicommon.Event) []watcher {
+	var watchers []watcher
+	for _, w := range gc.httpWatchers() {
+		ok, err := w.accepts(eventSource, event)
+		if err != nil {
+			gc.Log.Warn().Err(err).Str("event-source", eventSource).Str("watcher", w.name).Msg("failed to apply routing filter to event, routing it to watcher")
+			ok = true
+		}
+		if ok {
+			watchers = append(watchers, w)
+		}
+	}
+	return watchers
+}
+
+// deliverEvent posts the event to the watcher, retrying with backoff on failures
+func (gc *GatewayConfig) deliverEvent(w watcher, header http.Header, payload []byte) error {
+	var lastErr error
+	err := wait.ExponentialBackoff(dispatchBackoff, func() (bool, error) {
+		if lastErr = gc.postCloudEventToWatcher(w.url, header, payload); lastErr != nil {
+			if !isRetryableDelivery(lastErr) {
+				return false, lastErr
+			}
+			gc.Log.Debug().Err(lastErr).Str("watcher", w.name).Msg("failed to deliver event to watcher, retrying")
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		failedDeliveries.Add(w.name, 1)
+		return lastErr
+	}
+	deliveredEvents.Add(w.name, 1)
+	return nil
+}
+
+// retryQueue holds the failed deliveries waiting to be retried, ordered by the time they are retried
+type retryQueue struct {
+	// size is the maximum number of deliveries in the queue
+	size int
+	// lock protects the deliveries
+	lock sync.Mutex
+	// deliveries is a heap of the deliveries, the earliest retry first
+	deliveries retryHeap
+	// wakeup is signaled when a delivery is queued, as it may be retried before the deliveries already queued
+	wakeup chan struct{}
+}
+
+// newRetryQueue returns an empty retry queue of the size
+func newRetryQueue(size int) *retryQueue {
+	return &retryQueue{
+		size:   size,
+		wakeup: make(chan struct{}, 1),
+	}
+}
+
+// push queues the delivery, returning false if the queue is full
+func (q *retryQueue) push(delivery *pendingDelivery) bool {
+	return q.pushAll([]*pendingDelivery{delivery})
+}
+
+// pushAll queues all the deliveries, or none of them if they don't fit in the queue, in which case it returns false
+func (q *retryQueue) pushAll(deliveries []*pendingDelivery) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if len(q.deliveries)+len(deliveries) > q.size {
+		return false
+	}
+	for _, delivery := range deliveries {
+		heap.Push(&q.deliveries, delivery)
+	}
+	select {
+	case q.wakeup <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// len returns the number of deliveries in the queue
+func (q *retryQueue) len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return len(q.deliveries)
+}
+
+// next blocks until the earliest delivery is due and removes it from the queue
+func (q *retryQueue) next() *pendingDelivery {
+	for {
+		q.lock.Lock()
+		if len(q.deliveries) == 0 {
+			q.lock.Unlock()
+			<-q.wakeup
+			continue
+		}
+		delay := time.Until(q.deliveries[0].retryAt)
+		if delay <= 0 {
+			delivery := heap.Pop(&q.deliveries).(*pendingDelivery)
+			q.lock.Unlock()
+			return delivery
+		}
+		q.lock.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-q.wakeup:
+			timer.Stop()
+		}
+	}
+}
+
+// retryHeap implements heap.Interface over the deliveries, ordered by the time they are retried
+type retryHeap []*pendingDelivery
+
+func (h retryHeap) Len() int { return len(h) }
+
+func (h retryHeap) Less(i, j int) bool { return h[i].retryAt.Before(h[j].retryAt) }
+
+func (h retryHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *retryHeap) Push(x interface{}) { *h = append(*h, x.(*pendingDelivery)) }
+
+func (h *retryHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	delivery := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return delivery
+}
+
+// queueRetry queues a failed delivery to be retried later. The delivery is dropped if the retry queue is full, in which case
+// it returns false.
+func (gc *GatewayConfig) queueRetry(delivery *pendingDelivery) bool {
+	return gc.queueRetries([]*pendingDelivery{delivery})
+}
+
+// queueRetries queues the failed deliveries of an event to be retried later. The deliveries are all dropped if they don't fit
+// in the retry queue, in which case it returns false, so an event is never retried to some watchers only.
+func (gc *GatewayConfig) queueRetries(deliveries []*pendingDelivery) bool {
+	for _, delivery := range deliveries {
+		delivery.retryAt = time.Now().Add(time.Duration(delivery.attempts) * retryQueueDelay)
+	}
+	if !gc.retryQueue.pushAll(deliveries) {
+		for _, delivery := range deliveries {
+			droppedDeliveries.Add(delivery.watcher.name, 1)
+			gc.Log.Error().Str("watcher", delivery.watcher.name).Msg("retry queue is full, dropping event")
+		}
+		return false
+	}
+	pendingRetries.Add(int64(len(deliveries)))
+	return true
+}
+
+// processRetryQueue retries the failed deliveries once they are due. Each retry runs on its own, so the retries of a watcher
+// that is still failing don't hold back the retries of the other watchers.
+func (gc *GatewayConfig) processRetryQueue() {
+	for {
+		delivery := gc.retryQueue.next()
+		pendingRetries.Add(-1)
+		go gc.retryDelivery(delivery)
+	}
+}
+
+// retryDelivery retries the delivery, queueing it again if it fails until its attempts are exhausted.
+// The outbox is told once the retries of a delivery of an event of the outbox are over, so the event is kept in the outbox
+// only for the watchers it failed to be delivered to.
+func (gc *GatewayConfig) retryDelivery(delivery *pendingDelivery) {
+	retriedDeliveries.Add(delivery.watcher.name, 1)
+	err := gc.deliverEvent(delivery.watcher, delivery.header, delivery.payload)
+	if err == nil {
+		gc.Log.Info().Str("watcher", delivery.watcher.name).Int("attempts", delivery.attempts).Msg("event delivered to watcher on retry")
+		delivery.done(false)
+		return
+	}
+	delivery.attempts++
+	if !isRetryableDelivery(err) || delivery.attempts > maxDeliveryAttempts {
+		droppedDeliveries.Add(delivery.watcher.name, 1)
+		gc.Log.Error().Err(err).Str("watcher", delivery.watcher.name).Int("attempts", delivery.attempts).Msg("failed to deliver event to watcher, dropping event")
+		delivery.done(isRetryableDelivery(err))
+		return
+	}
+	if !gc.queueRetry(delivery) {
+		delivery.done(true)
+	}
+}
+
+// done tells the outbox, or the settlement of the retries, that the retries of the delivery are over.
+// The event is still to be delivered to the watcher if failed is true.
+func (d *pendingDelivery) done(failed bool) {
+	if d.outbox != nil {
+		d.outbox.done(d.watcher.name, failed)
+	}
+	if d.settlement != nil {
+		d.settlement.done(d.watcher.name, failed)
+	}
+}
+
+// recordDelivery records the outcome of the dispatch of an event of the event source.
+// An event filtered out by the event source is recorded as filtered rather than delivered.
+func (gc *GatewayConfig) recordDelivery(id string, name string, err error) {
+	gc.deliveryLock.Lock()
+	defer gc.deliveryLock.Unlock()
+	delivery, ok := gc.deliveries[id]
+	if !ok {
+		delivery = &eventSourceDelivery{
+			name: name,
+		}
+		gc.deliveries[id] = delivery
+	}
+	if err == ErrEventFiltered {
+		delivery.status.Filtered++
+		return
+	}
+	if err != nil {
+		delivery.status.Failed++
+		delivery.status.LastError = err.Error()
+		delivery.status.LastFailureTime = metav1.MicroTime{Time: time.Now().UTC()}
+		return
+	}
+	delivery.status.Delivered++
+}
+
+// reportDeliveries periodically reports the delivery outcomes of the event sources in the gateway resource.
+// Outcomes are aggregated between reports so the gateway resource is not updated on every event.
+func (gc *GatewayConfig) reportDeliveries() {
+	for range time.Tick(deliveryReportInterval) {
+		gc.deliveryLock.Lock()
+		deliveries := gc.deliveries
+		gc.deliveries = make(map[string]*eventSourceDelivery)
+		gc.deliveryLock.Unlock()
+
+		for id, delivery := range deliveries {
+			gc.StatusCh <- EventSourceStatus{
+				Phase:    v1alpha1.NodePhaseDeliveryUpdate,
+				Id:       id,
+				Name:     delivery.name,
+				Message:  "event_delivery_status_updated",
+				Delivery: delivery.status.DeepCopy(),
+			}
+		}
+	}
+}
+
+// ServeMetrics serves the metrics of the gateway client
+func (gc *GatewayConfig) ServeMetrics() {
+	// expvar registers its handler on the default mux
+	gc.Log.Info().Str("port", common.DefaultGatewayMetricsPort).Msg("serving gateway metrics")
+	if err := http.ListenAndServe(fmt.Sprintf(":%s", common.DefaultGatewayMetricsPort), nil); err != nil {
+		gc.Log.Error().Err(err).Msg("metrics server stopped")
+	}
+}
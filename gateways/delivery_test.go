@@ -0,0 +1,261 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateways
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
+	sv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestDeliverEvent(t *testing.T) {
+	convey.Convey("Given a gateway dispatching events over http", t, func() {
+		gc := getGatewayConfig()
+		gc.retryQueue = newRetryQueue(1)
+
+		var requests int32
+		failures := int32(0)
+		status := http.StatusServiceUnavailable
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) <= atomic.LoadInt32(&failures) {
+				w.WriteHeader(status)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		w := watcher{name: "sensor/test-sensor", url: server.URL}
+
+		convey.Convey("A transient failure must be retried", func() {
+			failures = 1
+			convey.So(gc.deliverEvent(w, nil, []byte("{}")), convey.ShouldBeNil)
+			convey.So(atomic.LoadInt32(&requests), convey.ShouldEqual, 2)
+		})
+
+		convey.Convey("A non 2xx response must be a failure", func() {
+			failures = int32(dispatchBackoff.Steps)
+			err := gc.deliverEvent(w, nil, []byte("{}"))
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(isRetryableDelivery(err), convey.ShouldBeTrue)
+			convey.So(atomic.LoadInt32(&requests), convey.ShouldEqual, dispatchBackoff.Steps)
+		})
+
+		convey.Convey("A rejected event must not be retried", func() {
+			failures = 1
+			status = http.StatusBadRequest
+			err := gc.deliverEvent(w, nil, []byte("{}"))
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(isRetryableDelivery(err), convey.ShouldBeFalse)
+			convey.So(atomic.LoadInt32(&requests), convey.ShouldEqual, 1)
+		})
+
+		convey.Convey("Failed deliveries must be dropped once the retry queue is full", func() {
+			gc.queueRetry(&pendingDelivery{watcher: w, payload: []byte("{}"), attempts: 1})
+			gc.queueRetry(&pendingDelivery{watcher: w, payload: []byte("{}"), attempts: 1})
+			convey.So(gc.retryQueue.len(), convey.ShouldEqual, 1)
+		})
+
+		convey.Convey("The failed deliveries of an event must be queued all together or not at all", func() {
+			gc.retryQueue = newRetryQueue(2)
+			failures = int32(dispatchBackoff.Steps) * 2
+			err := gc.dispatchEventOverHttp("test", []watcher{w, w}, nil, []byte("{}"), nil)
+			convey.So(err.(*deliveryError).settled, convey.ShouldNotBeNil)
+			convey.So(gc.retryQueue.len(), convey.ShouldEqual, 2)
+
+			gc.retryQueue = newRetryQueue(1)
+			atomic.StoreInt32(&requests, 0)
+			err = gc.dispatchEventOverHttp("test", []watcher{w, w}, nil, []byte("{}"), nil)
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(ackError(err), convey.ShouldNotBeNil)
+			convey.So(gc.retryQueue.len(), convey.ShouldEqual, 0)
+		})
+
+		convey.Convey("An event retried from the retry queue must only be acknowledged once its retries are over", func() {
+			gc.retryQueue = newRetryQueue(2)
+			failures = int32(dispatchBackoff.Steps) * 2
+			err := gc.dispatchEventOverHttp("test", []watcher{w, w}, nil, []byte("{}"), nil)
+			convey.So(err, convey.ShouldNotBeNil)
+
+			acked := make(chan error, 1)
+			go func() {
+				acked <- ackError(err)
+			}()
+			retries := append([]*pendingDelivery(nil), gc.retryQueue.deliveries...)
+			gc.retryDelivery(retries[0])
+			select {
+			case <-acked:
+				convey.So("acknowledged before the retries are over", convey.ShouldBeEmpty)
+			case <-time.After(100 * time.Millisecond):
+			}
+			gc.retryDelivery(retries[1])
+			convey.So(<-acked, convey.ShouldBeNil)
+		})
+
+		convey.Convey("An event rejected by the watchers must be acknowledged", func() {
+			failures = 1
+			status = http.StatusBadRequest
+			err := gc.dispatchEventOverHttp("test", []watcher{w}, nil, []byte("{}"), nil)
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(ackError(err), convey.ShouldBeNil)
+		})
+
+		convey.Convey("A due delivery must be retried before the deliveries that are retried later", func() {
+			gc.retryQueue = newRetryQueue(2)
+			later := &pendingDelivery{watcher: watcher{name: "sensor/failing-sensor"}, retryAt: time.Now().Add(time.Hour)}
+			due := &pendingDelivery{watcher: w, retryAt: time.Now()}
+			convey.So(gc.retryQueue.push(later), convey.ShouldBeTrue)
+			convey.So(gc.retryQueue.push(due), convey.ShouldBeTrue)
+			convey.So(gc.retryQueue.next(), convey.ShouldEqual, due)
+			convey.So(gc.retryQueue.len(), convey.ShouldEqual, 1)
+		})
+	})
+}
+
+func TestDeliveryStatus(t *testing.T) {
+	convey.Convey("Given a gateway with a running event source", t, func() {
+		gc := getGatewayConfig()
+		var err error
+		gc.gw, err = gc.gwcs.ArgoprojV1alpha1().Gateways(gc.gw.Namespace).Create(gc.gw)
+		convey.So(err, convey.ShouldBeNil)
+		gc.initializeNode("test-node", "test-node", "node is running")
+
+		convey.Convey("The delivery outcomes must be added to the node", func() {
+			gc.recordDelivery("test-node", "test-node", nil)
+			gc.recordDelivery("test-node", "test-node", fmt.Errorf("failed to dispatch event to watchers sensor/test-sensor"))
+			gc.recordDelivery("test-node", "test-node", ErrEventFiltered)
+			delivery := gc.deliveries["test-node"]
+			convey.So(delivery.status.Delivered, convey.ShouldEqual, 1)
+			convey.So(delivery.status.Failed, convey.ShouldEqual, 1)
+			convey.So(delivery.status.Filtered, convey.ShouldEqual, 1)
+
+			for i := 0; i < 2; i++ {
+				gc.UpdateGatewayResourceState(&EventSourceStatus{
+					Phase:    v1alpha1.NodePhaseDeliveryUpdate,
+					Id:       "test-node",
+					Name:     "test-node",
+					Delivery: delivery.status.DeepCopy(),
+				})
+			}
+			node := gc.gw.Status.Nodes["test-node"]
+			convey.So(node.Phase, convey.ShouldEqual, v1alpha1.NodePhaseRunning)
+			convey.So(node.Delivery.Delivered, convey.ShouldEqual, 2)
+			convey.So(node.Delivery.Failed, convey.ShouldEqual, 2)
+			convey.So(node.Delivery.Filtered, convey.ShouldEqual, 2)
+			convey.So(node.Delivery.LastError, convey.ShouldContainSubstring, "sensor/test-sensor")
+		})
+	})
+}
+
+func TestRouteEvent(t *testing.T) {
+	convey.Convey("Given a gateway with watchers routing the events", t, func() {
+		gc := getGatewayConfig()
+		gc.gw.Spec.Watchers = &v1alpha1.NotificationWatchers{
+			Sensors: []v1alpha1.SensorNotificationWatcher{
+				{
+					Name: "all-sensor",
+				},
+				{
+					Name: "push-sensor",
+					Routing: &v1alpha1.WatcherRouting{
+						EventSources: []string{"push"},
+					},
+				},
+				{
+					Name: "human-push-sensor",
+					Routing: &v1alpha1.WatcherRouting{
+						EventSources: []string{"push"},
+						Filter: &sv1alpha1.EventDependencyFilter{
+							Data: &sv1alpha1.Data{
+								Filters: []*sv1alpha1.DataFilter{
+									{
+										Path:  "sender.type",
+										Type:  sv1alpha1.JSONTypeString,
+										Value: "User",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Gateways: []v1alpha1.GatewayNotificationWatcher{
+				{
+					Name:     "issues-gateway",
+					Port:     "9300",
+					Endpoint: "/",
+					Routing: &v1alpha1.WatcherRouting{
+						EventSources: []string{"issues"},
+					},
+				},
+			},
+		}
+		event := func(payload string) *apicommon.Event {
+			return &apicommon.Event{
+				Context: apicommon.EventContext{
+					ContentType: "application/json",
+				},
+				Payload: []byte(payload),
+			}
+		}
+		names := func(watchers []watcher) []string {
+			var names []string
+			for _, w := range watchers {
+				names = append(names, w.name)
+			}
+			return names
+		}
+
+		convey.Convey("The event must be routed to the watchers of its event source passing their filters", func() {
+			watchers := gc.routeEvent("push", event(`{"sender":{"type":"User"}}`))
+			convey.So(names(watchers), convey.ShouldResemble, []string{"sensor/all-sensor", "sensor/push-sensor", "sensor/human-push-sensor"})
+
+			watchers = gc.routeEvent("push", event(`{"sender":{"type":"Bot"}}`))
+			convey.So(names(watchers), convey.ShouldResemble, []string{"sensor/all-sensor", "sensor/push-sensor"})
+
+			watchers = gc.routeEvent("issues", event(`{}`))
+			convey.So(names(watchers), convey.ShouldResemble, []string{"sensor/all-sensor", "gateway/issues-gateway"})
+		})
+
+		convey.Convey("An event routed to none of the watchers must be filtered out", func() {
+			gc.gw.Spec.EventProtocol.Type = apicommon.HTTP
+			gc.gw.Spec.Watchers.Sensors = gc.gw.Spec.Watchers.Sensors[1:]
+			err := gc.DispatchEvent(&Event{
+				Name:    "calendar",
+				Payload: []byte(`{}`),
+			})
+			convey.So(err, convey.ShouldEqual, ErrEventFiltered)
+		})
+
+		convey.Convey("The event must be routed to the watchers whose filter fails to apply to it", func() {
+			watchers := gc.routeEvent("push", &apicommon.Event{
+				Context: apicommon.EventContext{
+					ContentType: "text/plain",
+				},
+				Payload: []byte("ping"),
+			})
+			convey.So(names(watchers), convey.ShouldResemble, []string{"sensor/all-sensor", "sensor/push-sensor", "sensor/human-push-sensor"})
+		})
+	})
+}
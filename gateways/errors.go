@@ -24,4 +24,5 @@ var (
 	ErrEventSourceParseFailed = errors.New("failed to parse event source")
 	ErrEmptyEventSource       = errors.New("event source must be non empty")
 	ErrInvalidEventSource     = errors.New("invalid event source")
+	ErrEventFiltered          = errors.New("event is filtered out by the filters of the event source")
 )
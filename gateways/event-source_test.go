@@ -2,14 +2,18 @@ package gateways
 
 import (
 	"context"
+	"fmt"
 	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
+	"net"
 	"os"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-events/common"
 	gwfake "github.com/argoproj/argo-events/pkg/client/gateway/clientset/versioned/fake"
 	"github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
@@ -17,9 +21,13 @@ import (
 
 func getGatewayConfig() *GatewayConfig {
 	return &GatewayConfig{
-		Log:        common.GetLoggerContext(common.LoggerConf()).Logger(),
-		serverPort: "1234",
-		StatusCh:   make(chan EventSourceStatus),
+		Log:                common.GetLoggerContext(common.LoggerConf()).Logger(),
+		serverPort:         "1234",
+		StatusCh:           make(chan EventSourceStatus),
+		httpClient:         newDispatchClient(),
+		retryQueue:         newRetryQueue(retryQueueSize),
+		deliveries:         make(map[string]*eventSourceDelivery),
+		eventSourceOptions: make(map[string]*eventSourceOptions),
 		gw: &v1alpha1.Gateway{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "test-agteway",
@@ -130,3 +138,91 @@ testKey: testValue
 		wg.Wait()
 	})
 }
+
+type failingEventSourceExecutor struct{}
+
+func (ese *failingEventSourceExecutor) StartEventSource(eventSource *EventSource, eventStream Eventing_StartEventSourceServer) error {
+	return fmt.Errorf("broker is unavailable")
+}
+
+func (ese *failingEventSourceExecutor) ValidateEventSource(ctx context.Context, eventSource *EventSource) (*ValidEventSource, error) {
+	return &ValidEventSource{
+		IsValid: true,
+	}, nil
+}
+
+func TestEventSourceRestarts(t *testing.T) {
+	convey.Convey("Given an event source that keeps failing", t, func() {
+		lis, err := net.Listen("tcp", "localhost:0")
+		convey.So(err, convey.ShouldBeNil)
+		srv := grpc.NewServer()
+		RegisterEventingServer(srv, NewEventingServer(&failingEventSourceExecutor{}, common.GetLoggerContext(common.LoggerConf()).Logger()))
+		go srv.Serve(lis)
+		defer srv.Stop()
+
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+		convey.So(err, convey.ShouldBeNil)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		eventSource := &EventSourceContext{
+			Data: &EventSourceData{
+				ID:     "failing-event-source",
+				Src:    "failing-event-source",
+				Config: "testKey: testValue",
+			},
+			Ctx:    ctx,
+			Cancel: cancel,
+			Client: NewEventingClient(conn),
+			Conn:   conn,
+		}
+
+		gc := getGatewayConfig()
+		maxRestarts := int32(2)
+		gc.gw.Spec.RestartPolicy = &v1alpha1.EventSourceRestartPolicy{
+			MaxRestarts:  &maxRestarts,
+			InitialDelay: "10ms",
+			MaxDelay:     "1s",
+		}
+
+		convey.Convey("The event source must be restarted until the max restarts are exhausted", func() {
+			done := make(chan struct{})
+			go func() {
+				gc.superviseEventSource(eventSource)
+				close(done)
+			}()
+
+			var statuses []EventSourceStatus
+			for {
+				select {
+				case status := <-gc.StatusCh:
+					statuses = append(statuses, status)
+					continue
+				case <-done:
+				}
+				break
+			}
+
+			var failures []EventSourceStatus
+			for _, status := range statuses {
+				if status.Phase == v1alpha1.NodePhaseError {
+					failures = append(failures, status)
+				}
+			}
+			convey.So(failures, convey.ShouldHaveLength, 3)
+			convey.So(failures[0].RestartCount, convey.ShouldEqual, 1)
+			convey.So(failures[0].LastError, convey.ShouldContainSubstring, "broker is unavailable")
+			convey.So(failures[2].RestartCount, convey.ShouldEqual, 2)
+			convey.So(failures[2].Message, convey.ShouldEqual, "event_source_restarts_exhausted")
+		})
+
+		convey.Convey("The settings left out of the restart policy must default", func() {
+			gc.gw.Spec.RestartPolicy = &v1alpha1.EventSourceRestartPolicy{
+				InitialDelay: "10ms",
+			}
+			policy := gc.eventSourceRestartPolicy()
+			convey.So(policy.maxRestarts, convey.ShouldEqual, int32(common.DefaultEventSourceMaxRestarts))
+			convey.So(policy.initialDelay, convey.ShouldEqual, 10*time.Millisecond)
+			convey.So(policy.maxDelay, convey.ShouldEqual, common.DefaultEventSourceRestartMaxDelay)
+		})
+	})
+}
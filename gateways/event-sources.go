@@ -18,6 +18,7 @@ package gateways
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/argoproj/argo-events/pkg/apis/gateway"
 	"io"
@@ -25,29 +26,43 @@ import (
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
+	"github.com/ghodss/yaml"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
+// restartJitter is the jitter factor of the delay before an event source is restarted
+const restartJitter = 0.2
+
 // createInternalEventSources creates an internal representation of event source declared in the gateway configmap.
 // returned event sources are map of hash of event source and event source itself.
 // Creating a hash of event source makes it easy to check equality of two event sources.
 func (gc *GatewayConfig) createInternalEventSources(cm *corev1.ConfigMap) (map[string]*EventSourceContext, error) {
+	dialOptions, err := gc.serverDialOptions()
+	if err != nil {
+		return nil, err
+	}
+	address := gc.serverAddress()
+
 	configs := make(map[string]*EventSourceContext)
 	for configKey, configValue := range cm.Data {
 		hashKey := Hasher(configKey + configValue)
 		gc.Log.Info().Str("config-key", configKey).Str("config-value", configValue).Str("hash", string(hashKey)).Msg("event source")
 
-		// create a connection to gateway server
+		// create a connection to gateway server. The connection is established in the background and
+		// re-established whenever it breaks, the event source waits for it to be ready before it runs.
 		ctx, cancel := context.WithCancel(context.Background())
-		timeoutCtx, _ := context.WithTimeout(context.Background(), common.ServerConnTimeout*time.Second)
-		conn, err := grpc.DialContext(timeoutCtx, fmt.Sprintf("localhost:%s", gc.serverPort), grpc.WithBlock(), grpc.WithInsecure())
+		conn, err := grpc.Dial(address, dialOptions...)
 		if err != nil {
-			gc.Log.Panic().Err(err).Str("conn-state", conn.GetState().String()).Msg("failed to connect to gateway server")
+			gc.Log.Error().Err(err).Str("server-address", address).Msg("failed to connect to gateway server")
+			cancel()
 			return nil, err
 		}
-		gc.Log.Info().Str("state", conn.GetState().String()).Msg("state of the connection")
+		gc.Log.Info().Str("server-address", address).Str("state", conn.GetState().String()).Msg("state of the connection")
 
 		configs[hashKey] = &EventSourceContext{
 			Data: &EventSourceData{
@@ -120,103 +135,317 @@ func (gc *GatewayConfig) startEventSources(eventSources map[string]*EventSourceC
 		gc.registeredConfigs[key] = eventSource
 		gc.Log.Info().Str("event-source-name", eventSource.Data.Src).Msg("activating new event source")
 
-		go func() {
-			// conn should be in READY state
-			if eventSource.Conn.GetState() != connectivity.Ready {
-				gc.Log.Error().Msg("connection is not in ready state.")
-				gc.StatusCh <- EventSourceStatus{
-					Phase:   v1alpha1.NodePhaseError,
-					Id:      eventSource.Data.ID,
-					Message: "connection_is_not_in_ready_state",
-					Name:    eventSource.Data.Src,
-				}
-				return
-			}
+		go gc.superviseEventSource(eventSource)
+	}
+}
 
-			// validate event source
-			if valid, _ := eventSource.Client.ValidateEventSource(eventSource.Ctx, &EventSource{
-				Data: eventSource.Data.Config,
-				Name: eventSource.Data.Src,
-			}); !valid.IsValid {
-				gc.Log.Error().Str("event-source-name", eventSource.Data.Src).Str("validation-failure", valid.Reason).Msg("event source is not valid")
-				if err := eventSource.Conn.Close(); err != nil {
-					gc.Log.Error().Str("event-source-name", eventSource.Data.Src).Err(err).Msg("failed to close client connection")
-				}
-				gc.StatusCh <- EventSourceStatus{
-					Phase:   v1alpha1.NodePhaseError,
-					Id:      eventSource.Data.ID,
-					Message: "event_source_is_not_valid",
-					Name:    eventSource.Data.Src,
-				}
-				return
-			}
+// eventSourceFailure is the failure of an event source
+type eventSourceFailure struct {
+	// message is the status message of the node of the event source
+	message string
+	// err is the cause of the failure
+	err error
+	// permanent indicates a restart of the event source can't succeed
+	permanent bool
+}
 
-			gc.Log.Info().Str("event-source-name", eventSource.Data.Src).Msg("event source is valid")
+func (f *eventSourceFailure) Error() string {
+	if f.err == nil {
+		return f.message
+	}
+	return fmt.Sprintf("%s: %v", f.message, f.err)
+}
 
-			// mark event source as running
-			gc.StatusCh <- EventSourceStatus{
-				Phase:   v1alpha1.NodePhaseRunning,
-				Message: "event_source_is_running",
-				Id:      eventSource.Data.ID,
-				Name:    eventSource.Data.Src,
-			}
+// restartPolicy is the parsed restart policy of the event sources
+type restartPolicy struct {
+	maxRestarts  int32
+	initialDelay time.Duration
+	maxDelay     time.Duration
+}
+
+// eventSourceRestartPolicy returns the restart policy of the event sources of the gateway
+func (gc *GatewayConfig) eventSourceRestartPolicy() restartPolicy {
+	policy := restartPolicy{
+		maxRestarts:  common.DefaultEventSourceMaxRestarts,
+		initialDelay: common.DefaultEventSourceRestartInitialDelay,
+		maxDelay:     common.DefaultEventSourceRestartMaxDelay,
+	}
+	spec := gc.gw.Spec.RestartPolicy
+	if spec == nil {
+		return policy
+	}
+	if spec.MaxRestarts != nil {
+		policy.maxRestarts = *spec.MaxRestarts
+	}
+	// the policy is validated by the gateway controller
+	if delay, err := time.ParseDuration(spec.InitialDelay); err == nil {
+		policy.initialDelay = delay
+	}
+	if delay, err := time.ParseDuration(spec.MaxDelay); err == nil {
+		policy.maxDelay = delay
+	}
+	return policy
+}
+
+// superviseEventSource runs the event source and restarts it with an exponential backoff when it fails.
+// It stops restarting the event source once it failed more than the max restarts in a row, or if the failure is permanent.
+func (gc *GatewayConfig) superviseEventSource(eventSource *EventSourceContext) {
+	policy := gc.eventSourceRestartPolicy()
+	delay := policy.initialDelay
+	var restarts, consecutiveRestarts int32
+
+	for {
+		startedAt := time.Now()
+		failure := gc.runEventSource(eventSource)
+		if failure == nil || eventSource.Ctx.Err() != nil {
+			// the event source completed or was removed from the gateway
+			return
+		}
+		gc.Log.Error().Err(failure).Str("event-source-name", eventSource.Data.Src).Msg("event source failed")
+
+		status := EventSourceStatus{
+			Phase:        v1alpha1.NodePhaseError,
+			Id:           eventSource.Data.ID,
+			Name:         eventSource.Data.Src,
+			Message:      failure.message,
+			RestartCount: restarts,
+			LastError:    failure.Error(),
+		}
+		if failure.permanent {
+			gc.StatusCh <- status
+			return
+		}
+
+		// an event source that ran for a while before failing is healthy again
+		if time.Since(startedAt) > policy.maxDelay {
+			consecutiveRestarts = 0
+			delay = policy.initialDelay
+		}
+		if consecutiveRestarts >= policy.maxRestarts {
+			gc.Log.Error().Str("event-source-name", eventSource.Data.Src).Int32("restarts", consecutiveRestarts).Msg("event source failed too many times in a row, not restarting it")
+			status.Message = "event_source_restarts_exhausted"
+			gc.StatusCh <- status
+			gc.escalateEventSourceFailure(eventSource, "event source failed too many times in a row and won't be restarted")
+			return
+		}
 
-			// listen to events from gateway server
-			eventStream, err := eventSource.Client.StartEventSource(eventSource.Ctx, &EventSource{
-				Name: eventSource.Data.Src,
-				Data: eventSource.Data.Config,
-			})
-			if err != nil {
+		restarts++
+		consecutiveRestarts++
+		status.RestartCount = restarts
+		gc.StatusCh <- status
+
+		jittered := wait.Jitter(delay, restartJitter)
+		gc.Log.Info().Str("event-source-name", eventSource.Data.Src).Str("delay", jittered.String()).Int32("restarts", restarts).Msg("restarting event source")
+		select {
+		case <-time.After(jittered):
+		case <-eventSource.Ctx.Done():
+			return
+		}
+		delay *= 2
+		if delay > policy.maxDelay {
+			delay = policy.maxDelay
+		}
+	}
+}
+
+// escalateEventSourceFailure escalates the failure of an event source through a K8s event
+func (gc *GatewayConfig) escalateEventSourceFailure(eventSource *EventSourceContext, message string) {
+	labels := map[string]string{
+		common.LabelEventType:              string(common.EscalationEventType),
+		common.LabelGatewayEventSourceName: eventSource.Data.Src,
+		common.LabelGatewayName:            gc.Name,
+		common.LabelGatewayEventSourceID:   eventSource.Data.ID,
+		common.LabelOperation:              "restart_event_source",
+	}
+	if err := common.GenerateK8sEvent(gc.Clientset, message, common.EscalationEventType, "event source failed", gc.Name, gc.Namespace, gc.controllerInstanceID, gateway.Kind, labels); err != nil {
+		gc.Log.Error().Err(err).Str("event-source-name", eventSource.Data.Src).Msg("failed to create K8s event to escalate event source failure")
+	}
+}
+
+// waitForReadyConnection blocks until the connection to the gateway server is ready, or the connection timeout elapses
+func waitForReadyConnection(ctx context.Context, conn *grpc.ClientConn) bool {
+	timeoutCtx, cancel := context.WithTimeout(ctx, common.ServerConnTimeout*time.Second)
+	defer cancel()
+	for {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return true
+		}
+		if !conn.WaitForStateChange(timeoutCtx, state) {
+			return false
+		}
+	}
+}
+
+// parseEventSourceOptions parses the options of the event source out of its configuration
+func parseEventSourceOptions(config string) (*eventSourceOptions, error) {
+	var options *eventSourceOptions
+	if err := yaml.Unmarshal([]byte(config), &options); err != nil {
+		return nil, err
+	}
+	if options == nil {
+		return &eventSourceOptions{}, nil
+	}
+	for i := range options.Transforms {
+		if err := options.Transforms[i].validate(); err != nil {
+			return nil, fmt.Errorf("transform %d is not valid. err: %+v", i, err)
+		}
+	}
+	return options, nil
+}
+
+// setEventSourceOptions sets the options of the event source, nil options remove them
+func (gc *GatewayConfig) setEventSourceOptions(name string, options *eventSourceOptions) {
+	gc.optionsLock.Lock()
+	defer gc.optionsLock.Unlock()
+	if options == nil {
+		delete(gc.eventSourceOptions, name)
+		return
+	}
+	gc.eventSourceOptions[name] = options
+}
+
+// getEventSourceOptions returns the options of the event source, nil if the event source isn't running
+func (gc *GatewayConfig) getEventSourceOptions(name string) *eventSourceOptions {
+	gc.optionsLock.RLock()
+	defer gc.optionsLock.RUnlock()
+	return gc.eventSourceOptions[name]
+}
+
+// runEventSource validates and runs the event source, dispatching its events until its stream ends.
+// It returns the failure of the event source, nil if the event source completed.
+func (gc *GatewayConfig) runEventSource(eventSource *EventSourceContext) *eventSourceFailure {
+	options, err := parseEventSourceOptions(eventSource.Data.Config)
+	if err != nil {
+		gc.Log.Error().Err(err).Str("event-source-name", eventSource.Data.Src).Msg("event source options are not valid")
+		return &eventSourceFailure{
+			message:   "event_source_options_are_not_valid",
+			err:       err,
+			permanent: true,
+		}
+	}
+	gc.setEventSourceOptions(eventSource.Data.Src, options)
+
+	// conn should be in READY state
+	if !waitForReadyConnection(eventSource.Ctx, eventSource.Conn) {
+		gc.Log.Error().Msg("connection is not in ready state.")
+		return &eventSourceFailure{
+			message: "connection_is_not_in_ready_state",
+		}
+	}
+
+	// validate event source
+	valid, err := eventSource.Client.ValidateEventSource(eventSource.Ctx, &EventSource{
+		Data: eventSource.Data.Config,
+		Name: eventSource.Data.Src,
+	})
+	if err != nil {
+		return &eventSourceFailure{
+			message: "failed_to_validate_event_source",
+			err:     err,
+		}
+	}
+	if !valid.IsValid {
+		gc.Log.Error().Str("event-source-name", eventSource.Data.Src).Str("validation-failure", valid.Reason).Msg("event source is not valid")
+		if err := eventSource.Conn.Close(); err != nil {
+			gc.Log.Error().Str("event-source-name", eventSource.Data.Src).Err(err).Msg("failed to close client connection")
+		}
+		return &eventSourceFailure{
+			message:   "event_source_is_not_valid",
+			err:       errors.New(valid.Reason),
+			permanent: true,
+		}
+	}
+
+	gc.Log.Info().Str("event-source-name", eventSource.Data.Src).Msg("event source is valid")
+
+	// mark event source as running
+	gc.StatusCh <- EventSourceStatus{
+		Phase:   v1alpha1.NodePhaseRunning,
+		Message: "event_source_is_running",
+		Id:      eventSource.Data.ID,
+		Name:    eventSource.Data.Src,
+	}
+
+	// dispatch the events of the event source that were not dispatched before it restarted, alongside its new events
+	if gc.outbox != nil {
+		go gc.replayOutbox(eventSource.Ctx, eventSource.Data.Src)
+	}
+
+	// listen to events from gateway server, acknowledging each event once it is dispatched
+	acks := true
+	eventStream, err := gc.receiveEvents(eventSource, acks)
+	if err != nil {
+		return &eventSourceFailure{
+			message: "failed_to_receive_event_stream",
+			err:     err,
+		}
+	}
+	defer func() {
+		eventStream.Close()
+	}()
+
+	gc.Log.Info().Str("event-source-name", eventSource.Data.Src).Msg("started listening to events from gateway server")
+	for {
+		event, err := eventStream.Recv()
+		if err != nil {
+			if acks && status.Code(err) == codes.Unimplemented {
+				// gateway servers built before acknowledgements only stream events one way
+				gc.Log.Info().Str("event-source-name", eventSource.Data.Src).Msg("gateway server doesn't acknowledge events, falling back to one way event stream")
+				eventStream.Close()
+				acks = false
+				if eventStream, err = gc.receiveEvents(eventSource, acks); err != nil {
+					return &eventSourceFailure{
+						message: "failed_to_receive_event_stream",
+						err:     err,
+					}
+				}
+				continue
+			}
+			if err == io.EOF {
+				gc.Log.Info().Str("event-source-name", eventSource.Data.Src).Msg("event source has stopped")
 				gc.StatusCh <- EventSourceStatus{
-					Phase:   v1alpha1.NodePhaseError,
-					Message: "failed_to_receive_event_stream",
+					Phase:   v1alpha1.NodePhaseCompleted,
+					Message: "event_source_has_been_stopped",
 					Name:    eventSource.Data.Src,
 					Id:      eventSource.Data.ID,
 				}
-				return
+				return nil
 			}
 
-			gc.Log.Info().Str("event-source-name", eventSource.Data.Src).Msg("started listening to events from gateway server")
-			for {
-				event, err := eventStream.Recv()
-				if err != nil {
-					if err == io.EOF {
-						gc.Log.Info().Str("event-source-name", eventSource.Data.Src).Msg("event source has stopped")
-						gc.StatusCh <- EventSourceStatus{
-							Phase:   v1alpha1.NodePhaseCompleted,
-							Message: "event_source_has_been_stopped",
-							Name:    eventSource.Data.Src,
-							Id:      eventSource.Data.ID,
-						}
-						return
-					}
-
-					gc.Log.Error().Err(err).Str("event-source-name", eventSource.Data.Src).Msg("failed to receive event from stream")
-					gc.StatusCh <- EventSourceStatus{
-						Phase:   v1alpha1.NodePhaseError,
-						Message: "failed_to_receive_event_from_event_source_stream",
-						Name:    eventSource.Data.Src,
-						Id:      eventSource.Data.ID,
-					}
-					return
-				}
-				err = gc.DispatchEvent(event)
-				if err != nil {
-					// escalate error through a K8s event
-					labels := map[string]string{
-						common.LabelEventType:              string(common.EscalationEventType),
-						common.LabelGatewayEventSourceName: eventSource.Data.Src,
-						common.LabelGatewayName:            gc.Name,
-						common.LabelGatewayEventSourceID:   eventSource.Data.ID,
-						common.LabelOperation:              "dispatch_event_to_watchers",
-					}
-					if err := common.GenerateK8sEvent(gc.Clientset, fmt.Sprintf("failed to dispatch event to watchers"), common.EscalationEventType, "event dispatch failed", gc.Name, gc.Namespace, gc.controllerInstanceID, gateway.Kind, labels); err != nil {
-						gc.Log.Error().Err(err).Str("event-source-name", eventSource.Data.Src).Msg("failed to create K8s event to escalate event dispatch failure")
-					}
-					gc.Log.Error().Err(err).Str("event-source-name", eventSource.Data.Src).Msg("failed to dispatch event to watchers")
-				}
+			gc.Log.Error().Err(err).Str("event-source-name", eventSource.Data.Src).Msg("failed to receive event from stream")
+			return &eventSourceFailure{
+				message: "failed_to_receive_event_from_event_source_stream",
+				err:     err,
 			}
-		}()
+		}
+		err = gc.dispatchEvent(event)
+		gc.recordDelivery(eventSource.Data.ID, eventSource.Data.Src, err)
+		if err == ErrEventFiltered {
+			// filtered events are acknowledged like dispatched events
+			err = nil
+		}
+		if err != nil {
+			// escalate error through a K8s event
+			labels := map[string]string{
+				common.LabelEventType:              string(common.EscalationEventType),
+				common.LabelGatewayEventSourceName: eventSource.Data.Src,
+				common.LabelGatewayName:            gc.Name,
+				common.LabelGatewayEventSourceID:   eventSource.Data.ID,
+				common.LabelOperation:              "dispatch_event_to_watchers",
+			}
+			if err := common.GenerateK8sEvent(gc.Clientset, fmt.Sprintf("failed to dispatch event to watchers"), common.EscalationEventType, "event dispatch failed", gc.Name, gc.Namespace, gc.controllerInstanceID, gateway.Kind, labels); err != nil {
+				gc.Log.Error().Err(err).Str("event-source-name", eventSource.Data.Src).Msg("failed to create K8s event to escalate event dispatch failure")
+			}
+			gc.Log.Error().Err(err).Str("event-source-name", eventSource.Data.Src).Msg("failed to dispatch event to watchers")
+		}
+		// an event the outbox keeps is acknowledged, so the event source doesn't deliver it again on top of the retries of the outbox.
+		// An event retried from the retry queue is only acknowledged once it is delivered, as the retry queue doesn't survive a restart.
+		err = ackError(err)
+		// a failed acknowledgement means the stream is broken, which the next receive reports
+		if ackErr := eventStream.Ack(err); ackErr != nil {
+			gc.Log.Warn().Err(ackErr).Str("event-source-name", eventSource.Data.Src).Msg("failed to acknowledge event")
+		}
 	}
 }
 
@@ -225,6 +454,7 @@ func (gc *GatewayConfig) stopEventSources(configs []string) {
 	for _, configKey := range configs {
 		eventSource := gc.registeredConfigs[configKey]
 		delete(gc.registeredConfigs, configKey)
+		gc.setEventSourceOptions(eventSource.Data.Src, nil)
 		gc.Log.Info().Str("event-source-name", eventSource.Data.Src).Msg("removing the event source")
 		gc.StatusCh <- EventSourceStatus{
 			Phase:   v1alpha1.NodePhaseRemove,
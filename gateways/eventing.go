@@ -0,0 +1,414 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateways
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	zlog "github.com/rs/zerolog"
+	"google.golang.org/grpc"
+)
+
+const (
+	// keepaliveInterval is the interval at which both sides of the RunEventSource stream send keepalives
+	keepaliveInterval = 10 * time.Second
+	// keepaliveTimeout is the time after which a side of the RunEventSource stream that sent nothing is considered gone
+	keepaliveTimeout = 3 * keepaliveInterval
+)
+
+// ErrMissingEventSource is returned when the first request of the RunEventSource stream doesn't carry the event source
+var ErrMissingEventSource = errors.New("first request of the stream must carry the event source")
+
+// EventSourceExecutor runs and validates the event sources of a gateway server.
+// The event sources stream their events over StartEventSource. Over RunEventSource, sending an event
+// blocks until the gateway client acknowledges it.
+type EventSourceExecutor interface {
+	// StartEventSource starts an event source and streams its events.
+	StartEventSource(*EventSource, Eventing_StartEventSourceServer) error
+	// ValidateEventSource validates an event source.
+	ValidateEventSource(context.Context, *EventSource) (*ValidEventSource, error)
+}
+
+// DispatchError is returned when the gateway client acknowledged an event it failed to dispatch to the watchers
+type DispatchError struct {
+	Reason string
+}
+
+func (e *DispatchError) Error() string {
+	return fmt.Sprintf("gateway client failed to dispatch event. reason: %s", e.Reason)
+}
+
+// AckedEvent is an event of an event source along with the channel its acknowledgement is sent on
+type AckedEvent struct {
+	// Event is the event
+	Event *Event
+	// Ack receives nil once the gateway client dispatched the event, or the error that prevented it
+	Ack chan error
+}
+
+// NewAckedEvent returns an event waiting for its acknowledgement
+func NewAckedEvent(event *Event) *AckedEvent {
+	return &AckedEvent{
+		Event: event,
+		Ack:   make(chan error, 1),
+	}
+}
+
+// HandleAckedEventsFromEventSource handles events from an event source that acknowledges the messages it receives upstream,
+// like committing a Kafka offset or acking an AMQP message, only once the event is dispatched by the gateway client.
+// The name of the events is set by the handler. The acknowledgement of each event is sent on its Ack channel.
+func HandleAckedEventsFromEventSource(name string, eventStream Eventing_StartEventSourceServer, eventCh chan *AckedEvent, errorCh chan error, doneCh chan struct{}, log *zlog.Logger) error {
+	for {
+		select {
+		case acked := <-eventCh:
+			log.Info().Str("event-source-name", name).Msg("new event received, dispatching to gateway client")
+			acked.Event.Name = name
+			err := SendAndWaitForAck(eventStream, acked.Event)
+			acked.Ack <- err
+			if err != nil {
+				if _, ok := err.(*DispatchError); ok {
+					log.Warn().Str("event-source-name", name).Err(err).Msg("event is not acknowledged by gateway client")
+					continue
+				}
+				return err
+			}
+
+		case err := <-errorCh:
+			log.Info().Str("event-source-name", name).Err(err).Msg("error occurred while getting event from event source")
+			return err
+
+		case <-eventStream.Context().Done():
+			log.Info().Str("event-source-name", name).Msg("connection is closed by client")
+			doneCh <- struct{}{}
+			return nil
+		}
+	}
+}
+
+// SendAndWaitForAck sends the event to the gateway client and waits for its acknowledgement.
+// A *DispatchError is returned if the gateway client failed to dispatch the event. Over the one way
+// StartEventSource stream there are no acknowledgements, so the event is considered acknowledged once sent.
+func SendAndWaitForAck(eventStream Eventing_StartEventSourceServer, event *Event) error {
+	if stream, ok := eventStream.(*ackingStream); ok {
+		return stream.sendAndWait(event)
+	}
+	return eventStream.Send(event)
+}
+
+// eventingServer serves the Eventing service for an event source executor
+type eventingServer struct {
+	EventSourceExecutor
+	log zlog.Logger
+}
+
+// NewEventingServer returns the Eventing service of the event source executor
+func NewEventingServer(es EventSourceExecutor, log zlog.Logger) EventingServer {
+	return &eventingServer{
+		EventSourceExecutor: es,
+		log:                 log,
+	}
+}
+
+// RunEventSource starts the event source over a stream on which each event is acknowledged
+func (s *eventingServer) RunEventSource(stream Eventing_RunEventSourceServer) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if request.EventSource == nil {
+		return ErrMissingEventSource
+	}
+	ackStream := newAckingStream(stream, s.log.With().Str("event-source-name", request.EventSource.Name).Logger())
+	defer ackStream.cancel()
+	go ackStream.receive()
+	go ackStream.keepalive()
+	return s.StartEventSource(request.EventSource, ackStream)
+}
+
+// ackingStream adapts the RunEventSource stream to the StartEventSource stream, so event sources stream their events
+// the same way over both RPCs. Send blocks until the gateway client acknowledges the event, which holds back
+// the event source while the gateway client is dispatching the previous event.
+type ackingStream struct {
+	grpc.ServerStream
+	stream Eventing_RunEventSourceServer
+	log    zlog.Logger
+	// ctx is canceled once the client is gone
+	ctx    context.Context
+	cancel context.CancelFunc
+	// acks receives the acknowledgements of the client
+	acks chan *EventingRequest
+	// lastReceived is the time in nanoseconds of the last request of the client
+	lastReceived int64
+	// sequence is the sequence number of the last event sent
+	sequence uint64
+	// eventLock serializes the events
+	eventLock sync.Mutex
+	// sendLock serializes the writes to the stream
+	sendLock sync.Mutex
+}
+
+// newAckingStream returns the StartEventSource stream of a RunEventSource stream
+func newAckingStream(stream Eventing_RunEventSourceServer, log zlog.Logger) *ackingStream {
+	ctx, cancel := context.WithCancel(stream.Context())
+	return &ackingStream{
+		ServerStream: stream,
+		stream:       stream,
+		log:          log,
+		ctx:          ctx,
+		cancel:       cancel,
+		acks:         make(chan *EventingRequest, 1),
+		lastReceived: time.Now().UnixNano(),
+	}
+}
+
+// Context returns the context of the stream, canceled once the client is gone
+func (s *ackingStream) Context() context.Context {
+	return s.ctx
+}
+
+// Send sends the event and waits for its acknowledgement. An event the client failed to dispatch is not
+// an error of the stream, event sources that act on it use SendAndWaitForAck.
+func (s *ackingStream) Send(event *Event) error {
+	err := s.sendAndWait(event)
+	if _, ok := err.(*DispatchError); ok {
+		s.log.Warn().Err(err).Msg("event is not acknowledged by gateway client")
+		return nil
+	}
+	return err
+}
+
+// sendAndWait sends the event and waits for its acknowledgement
+func (s *ackingStream) sendAndWait(event *Event) error {
+	s.eventLock.Lock()
+	defer s.eventLock.Unlock()
+
+	s.sequence++
+	if err := s.send(&EventingResponse{
+		Event:    event,
+		Sequence: s.sequence,
+	}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case ack := <-s.acks:
+			if ack.Ack != s.sequence {
+				s.log.Warn().Uint64("ack", ack.Ack).Uint64("sequence", s.sequence).Msg("ignoring acknowledgement of unknown event")
+				continue
+			}
+			if ack.Error != "" {
+				return &DispatchError{
+					Reason: ack.Error,
+				}
+			}
+			return nil
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+}
+
+// send writes the response to the stream
+func (s *ackingStream) send(response *EventingResponse) error {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+	return s.stream.Send(response)
+}
+
+// receive reads the requests of the client until the stream is closed
+func (s *ackingStream) receive() {
+	defer s.cancel()
+	for {
+		request, err := s.stream.Recv()
+		if err != nil {
+			s.log.Debug().Err(err).Msg("stream is closed by client")
+			return
+		}
+		atomic.StoreInt64(&s.lastReceived, time.Now().UnixNano())
+		if request.Keepalive {
+			continue
+		}
+		select {
+		case s.acks <- request:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// keepalive sends keepalives to the client and closes the stream if the client stops sending requests
+func (s *ackingStream) keepalive() {
+	ticker := time.NewTicker(keepaliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if time.Since(time.Unix(0, atomic.LoadInt64(&s.lastReceived))) > keepaliveTimeout {
+				s.log.Warn().Msg("no keepalive received from gateway client, closing stream")
+				s.cancel()
+				return
+			}
+			if err := s.send(&EventingResponse{Keepalive: true}); err != nil {
+				s.log.Debug().Err(err).Msg("failed to send keepalive to gateway client")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// eventReceiver receives the events of an event source from the gateway server
+type eventReceiver interface {
+	// Recv returns the next event
+	Recv() (*Event, error)
+	// Ack reports the outcome of the dispatch of the last received event to the gateway server
+	Ack(err error) error
+	// Close releases the stream
+	Close()
+}
+
+// streamReceiver receives events over the one way StartEventSource stream, on which events are not acknowledged
+type streamReceiver struct {
+	Eventing_StartEventSourceClient
+	cancel context.CancelFunc
+}
+
+func (r *streamReceiver) Ack(err error) error {
+	return nil
+}
+
+func (r *streamReceiver) Close() {
+	r.cancel()
+}
+
+// ackingReceiver receives events over the RunEventSource stream and acknowledges them
+type ackingReceiver struct {
+	stream Eventing_RunEventSourceClient
+	log    zlog.Logger
+	ctx    context.Context
+	cancel context.CancelFunc
+	// lastReceived is the time in nanoseconds of the last response of the server
+	lastReceived int64
+	// sequence is the sequence number of the last received event
+	sequence uint64
+	// sendLock serializes the writes to the stream
+	sendLock sync.Mutex
+}
+
+// Recv returns the next event, skipping the keepalives of the server
+func (r *ackingReceiver) Recv() (*Event, error) {
+	for {
+		response, err := r.stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		atomic.StoreInt64(&r.lastReceived, time.Now().UnixNano())
+		if response.Keepalive {
+			continue
+		}
+		r.sequence = response.Sequence
+		return response.Event, nil
+	}
+}
+
+// Ack acknowledges the last received event, along with the error that prevented its dispatch
+func (r *ackingReceiver) Ack(err error) error {
+	request := &EventingRequest{
+		Ack: r.sequence,
+	}
+	if err != nil {
+		request.Error = err.Error()
+	}
+	return r.send(request)
+}
+
+func (r *ackingReceiver) Close() {
+	r.cancel()
+}
+
+// send writes the request to the stream
+func (r *ackingReceiver) send(request *EventingRequest) error {
+	r.sendLock.Lock()
+	defer r.sendLock.Unlock()
+	return r.stream.Send(request)
+}
+
+// keepalive sends keepalives to the server and closes the stream if the server stops sending responses
+func (r *ackingReceiver) keepalive() {
+	ticker := time.NewTicker(keepaliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if time.Since(time.Unix(0, atomic.LoadInt64(&r.lastReceived))) > keepaliveTimeout {
+				r.log.Warn().Msg("no keepalive received from gateway server, closing stream")
+				r.cancel()
+				return
+			}
+			if err := r.send(&EventingRequest{Keepalive: true}); err != nil {
+				r.log.Debug().Err(err).Msg("failed to send keepalive to gateway server")
+			}
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
+
+// receiveEvents starts the event source on the gateway server and returns the receiver of its events.
+// With acks, the events are received over RunEventSource, otherwise over StartEventSource.
+func (gc *GatewayConfig) receiveEvents(eventSource *EventSourceContext, acks bool) (eventReceiver, error) {
+	ctx, cancel := context.WithCancel(eventSource.Ctx)
+	request := &EventSource{
+		Name: eventSource.Data.Src,
+		Data: eventSource.Data.Config,
+	}
+	if !acks {
+		stream, err := eventSource.Client.StartEventSource(ctx, request)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		return &streamReceiver{
+			Eventing_StartEventSourceClient: stream,
+			cancel:                          cancel,
+		}, nil
+	}
+
+	stream, err := eventSource.Client.RunEventSource(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	receiver := &ackingReceiver{
+		stream:       stream,
+		log:          gc.Log.With().Str("event-source-name", eventSource.Data.Src).Logger(),
+		ctx:          ctx,
+		cancel:       cancel,
+		lastReceived: time.Now().UnixNano(),
+	}
+	if err := receiver.send(&EventingRequest{EventSource: request}); err != nil {
+		cancel()
+		return nil, err
+	}
+	go receiver.keepalive()
+	return receiver, nil
+}
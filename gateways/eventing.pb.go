@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	math "math"
 )
@@ -88,10 +89,17 @@ type Event struct {
 	// The event source name.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The event payload.
-	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The content type of the payload, e.g. application/json. Defaults to application/json.
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The time the event occurred at the event source. Defaults to the time the gateway received the event.
+	Time *timestamp.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	// The attributes of the event, e.g. the HTTP headers of a request, the key and offset of a Kafka message
+	// or the topic of a MQTT message.
+	Attributes           map[string]string `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Event) Reset()         { *m = Event{} }
@@ -133,6 +141,27 @@ func (m *Event) GetPayload() []byte {
 	return nil
 }
 
+func (m *Event) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *Event) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *Event) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
 //*
 // Represents if an event source is valid or not
 type ValidEventSource struct {
@@ -184,30 +213,178 @@ func (m *ValidEventSource) GetReason() string {
 	return ""
 }
 
+//*
+// Represents a message sent by the gateway client over the RunEventSource stream
+type EventingRequest struct {
+	// The event source to start. Set in the first request of the stream only.
+	EventSource *EventSource `protobuf:"bytes,1,opt,name=event_source,json=eventSource,proto3" json:"event_source,omitempty"`
+	// The sequence number of the acknowledged event.
+	Ack uint64 `protobuf:"varint,2,opt,name=ack,proto3" json:"ack,omitempty"`
+	// The reason the acknowledged event could not be dispatched to the watchers. Empty if the event was dispatched.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Whether the request is a keepalive.
+	Keepalive            bool     `protobuf:"varint,4,opt,name=keepalive,proto3" json:"keepalive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventingRequest) Reset()         { *m = EventingRequest{} }
+func (m *EventingRequest) String() string { return proto.CompactTextString(m) }
+func (*EventingRequest) ProtoMessage()    {}
+func (*EventingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c25325013aefc28a, []int{3}
+}
+
+func (m *EventingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventingRequest.Unmarshal(m, b)
+}
+func (m *EventingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventingRequest.Marshal(b, m, deterministic)
+}
+func (m *EventingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventingRequest.Merge(m, src)
+}
+func (m *EventingRequest) XXX_Size() int {
+	return xxx_messageInfo_EventingRequest.Size(m)
+}
+func (m *EventingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventingRequest proto.InternalMessageInfo
+
+func (m *EventingRequest) GetEventSource() *EventSource {
+	if m != nil {
+		return m.EventSource
+	}
+	return nil
+}
+
+func (m *EventingRequest) GetAck() uint64 {
+	if m != nil {
+		return m.Ack
+	}
+	return 0
+}
+
+func (m *EventingRequest) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *EventingRequest) GetKeepalive() bool {
+	if m != nil {
+		return m.Keepalive
+	}
+	return false
+}
+
+//*
+// Represents a message sent by the gateway server over the RunEventSource stream
+type EventingResponse struct {
+	// The event.
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// The sequence number of the event, acknowledged by the client once the event is dispatched.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Whether the response is a keepalive.
+	Keepalive            bool     `protobuf:"varint,3,opt,name=keepalive,proto3" json:"keepalive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventingResponse) Reset()         { *m = EventingResponse{} }
+func (m *EventingResponse) String() string { return proto.CompactTextString(m) }
+func (*EventingResponse) ProtoMessage()    {}
+func (*EventingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c25325013aefc28a, []int{4}
+}
+
+func (m *EventingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventingResponse.Unmarshal(m, b)
+}
+func (m *EventingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventingResponse.Marshal(b, m, deterministic)
+}
+func (m *EventingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventingResponse.Merge(m, src)
+}
+func (m *EventingResponse) XXX_Size() int {
+	return xxx_messageInfo_EventingResponse.Size(m)
+}
+func (m *EventingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventingResponse proto.InternalMessageInfo
+
+func (m *EventingResponse) GetEvent() *Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *EventingResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *EventingResponse) GetKeepalive() bool {
+	if m != nil {
+		return m.Keepalive
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*EventSource)(nil), "gateways.EventSource")
 	proto.RegisterType((*Event)(nil), "gateways.Event")
+	proto.RegisterMapType((map[string]string)(nil), "gateways.Event.AttributesEntry")
 	proto.RegisterType((*ValidEventSource)(nil), "gateways.ValidEventSource")
+	proto.RegisterType((*EventingRequest)(nil), "gateways.EventingRequest")
+	proto.RegisterType((*EventingResponse)(nil), "gateways.EventingResponse")
 }
 
 func init() { proto.RegisterFile("gateways/eventing.proto", fileDescriptor_c25325013aefc28a) }
 
 var fileDescriptor_c25325013aefc28a = []byte{
-	// 224 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xb1, 0x4a, 0xc7, 0x30,
-	0x10, 0x87, 0x49, 0xd5, 0xb6, 0x9e, 0xa2, 0x25, 0xa2, 0x86, 0x4e, 0xd2, 0xc9, 0xa9, 0x8a, 0xe2,
-	0xe6, 0x68, 0xc1, 0xb9, 0x05, 0xf7, 0xd3, 0x84, 0x12, 0xa8, 0x49, 0x49, 0xa3, 0xd2, 0xd7, 0xf0,
-	0x89, 0xa5, 0x67, 0xa3, 0xa1, 0xd3, 0x7f, 0xbb, 0xfb, 0x8e, 0xfb, 0x72, 0xbf, 0xc0, 0x65, 0x8f,
-	0x5e, 0x7d, 0xe1, 0x3c, 0xdd, 0xa8, 0x4f, 0x65, 0xbc, 0x36, 0x7d, 0x3d, 0x3a, 0xeb, 0x2d, 0xcf,
-	0xc3, 0xa0, 0x6a, 0xe0, 0xa8, 0x59, 0x66, 0x9d, 0xfd, 0x70, 0x6f, 0x8a, 0x9f, 0x40, 0xa2, 0xa5,
-	0x60, 0x57, 0xec, 0xfa, 0xb0, 0x4d, 0xb4, 0xe4, 0x1c, 0xf6, 0x0d, 0xbe, 0x2b, 0x91, 0x10, 0xa1,
-	0x7a, 0x61, 0x12, 0x3d, 0x8a, 0xbd, 0x5f, 0xb6, 0xd4, 0xd5, 0x03, 0x1c, 0x90, 0xe6, 0x6f, 0x81,
-	0x45, 0x0b, 0x02, 0xb2, 0x11, 0xe7, 0xc1, 0xa2, 0x24, 0xcf, 0x71, 0x1b, 0xda, 0xea, 0x09, 0x8a,
-	0x17, 0x1c, 0xb4, 0x8c, 0x4f, 0x10, 0x90, 0xe9, 0x89, 0x28, 0x49, 0xf2, 0x36, 0xb4, 0xfc, 0x02,
-	0x52, 0xa7, 0x70, 0xb2, 0x66, 0x3d, 0x67, 0xed, 0xee, 0xbe, 0x19, 0xe4, 0xcd, 0x1a, 0x90, 0x3f,
-	0x42, 0xd1, 0x79, 0x74, 0x3e, 0x56, 0x9e, 0xd7, 0x21, 0x6f, 0x1d, 0xe1, 0xf2, 0x74, 0x83, 0x6f,
-	0x19, 0x7f, 0x86, 0x33, 0x7a, 0x0b, 0xbd, 0xda, 0x41, 0x50, 0xfe, 0xe3, 0x6d, 0x8c, 0xd7, 0x94,
-	0x7e, 0xfa, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x9d, 0xb2, 0xf4, 0x84, 0x01, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8d, 0x52, 0x4d, 0x8b, 0xdb, 0x30,
+	0x10, 0x45, 0xf9, 0xd8, 0x7a, 0xc7, 0x61, 0x13, 0xd4, 0x2f, 0xd7, 0x14, 0x76, 0x6b, 0x28, 0xec,
+	0xc9, 0x59, 0xb2, 0x97, 0xa5, 0xb4, 0x94, 0x42, 0x03, 0x85, 0xde, 0xb4, 0x4b, 0xaf, 0x8b, 0x92,
+	0x4c, 0x83, 0x49, 0x62, 0x79, 0x25, 0x39, 0xc5, 0xff, 0x60, 0xaf, 0xfd, 0x85, 0xfd, 0x2b, 0x95,
+	0x64, 0x39, 0x71, 0xdd, 0x1e, 0x7a, 0x9b, 0x79, 0xa3, 0x79, 0xf3, 0xe6, 0x8d, 0xe0, 0xe5, 0x9a,
+	0x6b, 0xfc, 0xc1, 0x2b, 0x35, 0xc5, 0x3d, 0xe6, 0x3a, 0xcb, 0xd7, 0x69, 0x21, 0x85, 0x16, 0x34,
+	0x68, 0x0a, 0xf1, 0xf9, 0x5a, 0x88, 0xf5, 0x16, 0xa7, 0x0e, 0x5f, 0x94, 0xdf, 0xa7, 0x3a, 0xdb,
+	0xa1, 0xd2, 0x7c, 0x57, 0xd4, 0x4f, 0x93, 0x39, 0x84, 0x73, 0xdb, 0x7c, 0x2b, 0x4a, 0xb9, 0x44,
+	0x7a, 0x06, 0xbd, 0x6c, 0x15, 0x91, 0x0b, 0x72, 0x79, 0xca, 0x4c, 0x44, 0x29, 0x0c, 0x72, 0xbe,
+	0xc3, 0xa8, 0xe7, 0x10, 0x17, 0x5b, 0x6c, 0xc5, 0x35, 0x8f, 0xfa, 0x35, 0x66, 0xe3, 0xe4, 0xb1,
+	0x07, 0x43, 0xc7, 0x73, 0xe8, 0x20, 0xad, 0x8e, 0x08, 0x9e, 0x14, 0xbc, 0xda, 0x0a, 0xbe, 0x72,
+	0x44, 0x23, 0xd6, 0xa4, 0xf4, 0x0d, 0x8c, 0x96, 0x22, 0xd7, 0xa6, 0xf1, 0x5e, 0x57, 0x05, 0x7a,
+	0xce, 0xd0, 0x63, 0x77, 0x06, 0xa2, 0x29, 0x0c, 0xac, 0xe8, 0x68, 0x60, 0x4a, 0xe1, 0x2c, 0x4e,
+	0xeb, 0x8d, 0xd2, 0x66, 0xa3, 0xf4, 0xae, 0xd9, 0x88, 0xb9, 0x77, 0xf4, 0x23, 0x00, 0xd7, 0x5a,
+	0x66, 0x8b, 0x52, 0xa3, 0x8a, 0x86, 0x17, 0x7d, 0xd3, 0x75, 0x9e, 0x36, 0x8e, 0xa4, 0x4e, 0x65,
+	0xfa, 0xe9, 0xf0, 0x62, 0x9e, 0x6b, 0x59, 0xb1, 0x56, 0x4b, 0xfc, 0x01, 0xc6, 0x9d, 0x32, 0x9d,
+	0x40, 0x7f, 0x83, 0x95, 0xdf, 0xc9, 0x86, 0xf4, 0x19, 0x0c, 0xf7, 0x7c, 0x5b, 0x36, 0xce, 0xd4,
+	0xc9, 0xbb, 0xde, 0x0d, 0x49, 0x3e, 0xc3, 0xe4, 0x1b, 0xdf, 0x66, 0xab, 0xb6, 0xad, 0xc6, 0x80,
+	0x4c, 0x39, 0xd4, 0x71, 0x04, 0xac, 0x49, 0xe9, 0x0b, 0x38, 0x91, 0xc8, 0x95, 0xc8, 0x3d, 0x91,
+	0xcf, 0x92, 0x9f, 0x04, 0xc6, 0x73, 0x7f, 0x55, 0x86, 0x0f, 0xa5, 0x59, 0x91, 0xde, 0xc0, 0xc8,
+	0x1d, 0xfa, 0x5e, 0x39, 0x56, 0x47, 0x15, 0xce, 0x9e, 0x77, 0x76, 0xab, 0x47, 0xb2, 0x10, 0x5b,
+	0xf3, 0x8d, 0x7e, 0xbe, 0xdc, 0xb8, 0x11, 0x03, 0x66, 0x43, 0xab, 0x1f, 0xa5, 0x14, 0xd2, 0x3b,
+	0x5e, 0x27, 0xf4, 0x35, 0x9c, 0x6e, 0x10, 0x0b, 0xa3, 0x6c, 0x5f, 0x1b, 0x1e, 0xb0, 0x23, 0x90,
+	0x28, 0x98, 0x1c, 0x25, 0xa9, 0x42, 0xe4, 0x0a, 0xe9, 0x5b, 0xc3, 0x63, 0x31, 0x2f, 0x66, 0xdc,
+	0x11, 0xc3, 0xea, 0x2a, 0x8d, 0x21, 0x50, 0x76, 0x8b, 0x7c, 0x89, 0x5e, 0xc5, 0x21, 0xff, 0x73,
+	0x68, 0xbf, 0x33, 0x74, 0xf6, 0x8b, 0x40, 0xd0, 0x4c, 0xa5, 0xef, 0x61, 0x72, 0xab, 0xb9, 0xd4,
+	0x6d, 0x6f, 0xff, 0xbd, 0x7f, 0xdc, 0x55, 0x72, 0x45, 0xe8, 0x17, 0x78, 0xea, 0x4c, 0x37, 0xf8,
+	0x7f, 0x10, 0xc4, 0x47, 0xf8, 0xaf, 0x7b, 0x7e, 0x85, 0x33, 0x56, 0xe6, 0x6d, 0xe4, 0x55, 0x87,
+	0xe4, 0x78, 0xb6, 0x36, 0x51, 0xd7, 0xbe, 0x4b, 0x72, 0x45, 0x16, 0x27, 0xee, 0x2b, 0x5f, 0xff,
+	0x06, 0x4d, 0xa5, 0xf4, 0x36, 0xcf, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -226,6 +403,10 @@ type EventingClient interface {
 	StartEventSource(ctx context.Context, in *EventSource, opts ...grpc.CallOption) (Eventing_StartEventSourceClient, error)
 	// ValidateEventSource validates an event source.
 	ValidateEventSource(ctx context.Context, in *EventSource, opts ...grpc.CallOption) (*ValidEventSource, error)
+	// RunEventSource starts the event source sent in the first request and streams its events.
+	// The client acknowledges each event once it is dispatched and the server waits for the acknowledgement
+	// before it sends the next event. Both sides send keepalives while the stream is idle.
+	RunEventSource(ctx context.Context, opts ...grpc.CallOption) (Eventing_RunEventSourceClient, error)
 }
 
 type eventingClient struct {
@@ -277,12 +458,47 @@ func (c *eventingClient) ValidateEventSource(ctx context.Context, in *EventSourc
 	return out, nil
 }
 
+func (c *eventingClient) RunEventSource(ctx context.Context, opts ...grpc.CallOption) (Eventing_RunEventSourceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Eventing_serviceDesc.Streams[1], "/gateways.Eventing/RunEventSource", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventingRunEventSourceClient{stream}
+	return x, nil
+}
+
+type Eventing_RunEventSourceClient interface {
+	Send(*EventingRequest) error
+	Recv() (*EventingResponse, error)
+	grpc.ClientStream
+}
+
+type eventingRunEventSourceClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventingRunEventSourceClient) Send(m *EventingRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *eventingRunEventSourceClient) Recv() (*EventingResponse, error) {
+	m := new(EventingResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // EventingServer is the server API for Eventing service.
 type EventingServer interface {
 	// StartEventSource starts an event source and returns stream of events.
 	StartEventSource(*EventSource, Eventing_StartEventSourceServer) error
 	// ValidateEventSource validates an event source.
 	ValidateEventSource(context.Context, *EventSource) (*ValidEventSource, error)
+	// RunEventSource starts the event source sent in the first request and streams its events.
+	// The client acknowledges each event once it is dispatched and the server waits for the acknowledgement
+	// before it sends the next event. Both sides send keepalives while the stream is idle.
+	RunEventSource(Eventing_RunEventSourceServer) error
 }
 
 func RegisterEventingServer(s *grpc.Server, srv EventingServer) {
@@ -328,6 +544,32 @@ func _Eventing_ValidateEventSource_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Eventing_RunEventSource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EventingServer).RunEventSource(&eventingRunEventSourceServer{stream})
+}
+
+type Eventing_RunEventSourceServer interface {
+	Send(*EventingResponse) error
+	Recv() (*EventingRequest, error)
+	grpc.ServerStream
+}
+
+type eventingRunEventSourceServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventingRunEventSourceServer) Send(m *EventingResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *eventingRunEventSourceServer) Recv() (*EventingRequest, error) {
+	m := new(EventingRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Eventing_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gateways.Eventing",
 	HandlerType: (*EventingServer)(nil),
@@ -343,6 +585,12 @@ var _Eventing_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Eventing_StartEventSource_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RunEventSource",
+			Handler:       _Eventing_RunEventSource_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "gateways/eventing.proto",
 }
@@ -0,0 +1,127 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateways
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+)
+
+// ackedEventSourceExecutor streams two events and reports their acknowledgements
+type ackedEventSourceExecutor struct {
+	acks chan error
+}
+
+func (ese *ackedEventSourceExecutor) StartEventSource(eventSource *EventSource, eventStream Eventing_StartEventSourceServer) error {
+	eventCh := make(chan *AckedEvent)
+	errorCh := make(chan error)
+	doneCh := make(chan struct{}, 1)
+	go func() {
+		for _, payload := range []string{"first", "second"} {
+			event := NewAckedEvent(&Event{
+				Payload: []byte(payload),
+			})
+			eventCh <- event
+			ese.acks <- <-event.Ack
+		}
+	}()
+	log := common.GetLoggerContext(common.LoggerConf()).Logger()
+	return HandleAckedEventsFromEventSource(eventSource.Name, eventStream, eventCh, errorCh, doneCh, &log)
+}
+
+func (ese *ackedEventSourceExecutor) ValidateEventSource(ctx context.Context, eventSource *EventSource) (*ValidEventSource, error) {
+	return &ValidEventSource{
+		IsValid: true,
+	}, nil
+}
+
+func TestRunEventSource(t *testing.T) {
+	convey.Convey("Given an event source acknowledging its events", t, func() {
+		lis, err := net.Listen("tcp", "localhost:0")
+		convey.So(err, convey.ShouldBeNil)
+		executor := &ackedEventSourceExecutor{
+			acks: make(chan error, 2),
+		}
+		srv := grpc.NewServer()
+		RegisterEventingServer(srv, NewEventingServer(executor, common.GetLoggerContext(common.LoggerConf()).Logger()))
+		go srv.Serve(lis)
+		defer srv.Stop()
+
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+		convey.So(err, convey.ShouldBeNil)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		eventSource := &EventSourceContext{
+			Data: &EventSourceData{
+				ID:     "acked-event-source",
+				Src:    "acked-event-source",
+				Config: "testKey: testValue",
+			},
+			Ctx:    ctx,
+			Cancel: cancel,
+			Client: NewEventingClient(conn),
+			Conn:   conn,
+		}
+		gc := getGatewayConfig()
+
+		convey.Convey("The next event must only be sent once the event is acknowledged", func() {
+			receiver, err := gc.receiveEvents(eventSource, true)
+			convey.So(err, convey.ShouldBeNil)
+			defer receiver.Close()
+
+			event, err := receiver.Recv()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(string(event.Payload), convey.ShouldEqual, "first")
+			convey.So(event.Name, convey.ShouldEqual, "acked-event-source")
+
+			select {
+			case <-executor.acks:
+				t.Fatal("event is acknowledged before the client acknowledged it")
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			convey.So(receiver.Ack(nil), convey.ShouldBeNil)
+			convey.So(<-executor.acks, convey.ShouldBeNil)
+
+			event, err = receiver.Recv()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(string(event.Payload), convey.ShouldEqual, "second")
+
+			convey.So(receiver.Ack(errors.New("watcher is unavailable")), convey.ShouldBeNil)
+			ack := <-executor.acks
+			convey.So(ack, convey.ShouldHaveSameTypeAs, &DispatchError{})
+			convey.So(ack.Error(), convey.ShouldContainSubstring, "watcher is unavailable")
+		})
+
+		convey.Convey("The event source must stop once the client closes the stream", func() {
+			receiver, err := gc.receiveEvents(eventSource, true)
+			convey.So(err, convey.ShouldBeNil)
+			_, err = receiver.Recv()
+			convey.So(err, convey.ShouldBeNil)
+			receiver.Close()
+
+			convey.So(<-executor.acks, convey.ShouldNotBeNil)
+		})
+	})
+}
@@ -17,17 +17,24 @@ limitations under the License.
 package gateways
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
+	"time"
 
 	"github.com/argoproj/argo-events/common"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	zlog "github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 // StartGateway start a gateway
-func StartGateway(es EventingServer) {
+func StartGateway(es EventSourceExecutor) {
 	port, ok := os.LookupEnv(common.EnvVarGatewayServerPort)
 	if !ok {
 		panic(fmt.Errorf("port is not provided"))
@@ -36,8 +43,16 @@ func StartGateway(es EventingServer) {
 	if err != nil {
 		panic(err)
 	}
-	srv := grpc.NewServer()
-	RegisterEventingServer(srv, es)
+	var options []grpc.ServerOption
+	creds, err := serverCredentials()
+	if err != nil {
+		panic(err)
+	}
+	if creds != nil {
+		options = append(options, grpc.Creds(creds))
+	}
+	srv := grpc.NewServer(options...)
+	RegisterEventingServer(srv, NewEventingServer(es, common.GetLoggerContext(common.LoggerConf()).Logger()))
 
 	fmt.Println("starting gateway server")
 
@@ -46,6 +61,39 @@ func StartGateway(es EventingServer) {
 	}
 }
 
+// serverCredentials returns the TLS credentials of the gateway server, nil if the gateway server doesn't serve TLS.
+// With a client CA, the gateway clients must present a certificate signed by the CA.
+func serverCredentials() (credentials.TransportCredentials, error) {
+	certFile, ok := os.LookupEnv(common.EnvVarGatewayServerTLSCert)
+	if !ok {
+		return nil, nil
+	}
+	keyFile, ok := os.LookupEnv(common.EnvVarGatewayServerTLSKey)
+	if !ok {
+		return nil, fmt.Errorf("key of the gateway server certificate is not provided")
+	}
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load gateway server certificate. err: %+v", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+	}
+	if caFile, ok := os.LookupEnv(common.EnvVarGatewayServerTLSClientCA); ok {
+		caCert, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA certificate. err: %+v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("failed to parse client CA certificate")
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
 // Recover recovers from panics in event sources
 func Recover(eventSource string) {
 	if r := recover(); r != nil {
@@ -78,3 +126,39 @@ func HandleEventsFromEventSource(name string, eventStream Eventing_StartEventSou
 		}
 	}
 }
+
+// HandleEventsWithMetadataFromEventSource handles events from the event source that carry metadata along with their payload,
+// like the attributes, content type or time of the messages the event source receives. The name of the events is set by the handler.
+func HandleEventsWithMetadataFromEventSource(name string, eventStream Eventing_StartEventSourceServer, eventCh chan *Event, errorCh chan error, doneCh chan struct{}, log *zlog.Logger) error {
+	for {
+		select {
+		case event := <-eventCh:
+			log.Info().Str("event-source-name", name).Msg("new event received, dispatching to gateway client")
+			event.Name = name
+			if err := eventStream.Send(event); err != nil {
+				return err
+			}
+
+		case err := <-errorCh:
+			log.Info().Str("event-source-name", name).Err(err).Msg("error occurred while getting event from event source")
+			return err
+
+		case <-eventStream.Context().Done():
+			log.Info().Str("event-source-name", name).Msg("connection is closed by client")
+			doneCh <- struct{}{}
+			return nil
+		}
+	}
+}
+
+// EventTime returns the time of an event received by an event source. A zero time is left unset.
+func EventTime(t time.Time) *timestamp.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return nil
+	}
+	return ts
+}
@@ -0,0 +1,550 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
+	"github.com/golang/protobuf/ptypes"
+	suuid "github.com/satori/go.uuid"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// defaultOutboxMaxSize is the default maximum size of the outbox
+	defaultOutboxMaxSize = "100Mi"
+	// defaultOutboxMaxAge is the default duration after which events are dropped from the outbox
+	defaultOutboxMaxAge = 24 * time.Hour
+	// outboxEntrySuffix is the suffix of the files holding the events in the outbox
+	outboxEntrySuffix = ".event"
+	// outboxTempPrefix is the prefix of the files being written in the outbox
+	outboxTempPrefix = ".tmp-"
+)
+
+// outboxDroppedEvents is the number of events dropped from the outbox before they could be dispatched
+var outboxDroppedEvents = expvar.NewInt("gateway_outbox_dropped_events")
+
+// outbox persists the events of the gateway on disk until they are dispatched to the watchers.
+// Each event is stored in its own file, named after the time it was persisted so the entries sort in the order of the events,
+// and after its event source so the events of an event source are replayed once the event source is running.
+// The entries are indexed in memory when the outbox is opened, so persisting an event doesn't read the directory.
+type outbox struct {
+	// dir is the directory of the outbox
+	dir string
+	// maxSize is the maximum size in bytes of the events in the outbox
+	maxSize int64
+	// maxAge is the duration after which events are dropped from the outbox
+	maxAge time.Duration
+	// lock serializes the updates of the outbox by the event sources and the retries
+	lock sync.Mutex
+	// entries holds the entries of the outbox, the oldest first
+	entries []*outboxEntry
+	// size is the size in bytes of the events in the outbox
+	size int64
+}
+
+// outboxEntry is an event in the outbox
+type outboxEntry struct {
+	// name of the file holding the event
+	name string
+	// eventSource is the name of the event source of the event
+	eventSource string
+	// size of the file holding the event
+	size int64
+	// persistedAt is the time the event was persisted
+	persistedAt time.Time
+	// dispatching is true while the event is dispatched or its deliveries are retried
+	dispatching bool
+}
+
+// outboxRecord is the content of an entry of the outbox
+type outboxRecord struct {
+	// Event is the event to dispatch
+	Event *Event `json:"event"`
+	// EventID is the id of the CloudEvents event the event is dispatched as, so the watchers receive the same event whenever
+	// it is dispatched. Empty for entries persisted before the id was kept, which get a new id on every dispatch.
+	EventID string `json:"eventId,omitempty"`
+	// Watchers holds the names of the watchers the event is still to be delivered to. Empty if the event is to be dispatched to all of them.
+	Watchers []string `json:"watchers,omitempty"`
+}
+
+// newOutbox returns the outbox of the gateway, creating its directory if needed and indexing the entries left by a previous run
+func newOutbox(spec *v1alpha1.Outbox) (*outbox, error) {
+	maxSize := spec.MaxSize
+	if maxSize == "" {
+		maxSize = defaultOutboxMaxSize
+	}
+	size, err := resource.ParseQuantity(maxSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse outbox max size. err: %+v", err)
+	}
+	maxAge := defaultOutboxMaxAge
+	if spec.MaxAge != "" {
+		if maxAge, err = time.ParseDuration(spec.MaxAge); err != nil {
+			return nil, fmt.Errorf("failed to parse outbox max age. err: %+v", err)
+		}
+	}
+	if err := os.MkdirAll(spec.Path, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create outbox directory. err: %+v", err)
+	}
+	o := &outbox{
+		dir:     spec.Path,
+		maxSize: size.Value(),
+		maxAge:  maxAge,
+	}
+	if err := o.load(); err != nil {
+		return nil, fmt.Errorf("failed to read outbox directory. err: %+v", err)
+	}
+	return o, nil
+}
+
+// load indexes the entries in the directory of the outbox, removing the files a crash left partially written
+func (o *outbox) load() error {
+	files, err := ioutil.ReadDir(o.dir)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		if strings.HasPrefix(file.Name(), outboxTempPrefix) {
+			os.Remove(filepath.Join(o.dir, file.Name()))
+			continue
+		}
+		if !strings.HasSuffix(file.Name(), outboxEntrySuffix) {
+			continue
+		}
+		parts := strings.SplitN(strings.TrimSuffix(file.Name(), outboxEntrySuffix), "-", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		nanos, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		o.entries = append(o.entries, &outboxEntry{
+			name:        file.Name(),
+			eventSource: parts[2],
+			size:        file.Size(),
+			persistedAt: time.Unix(0, nanos),
+		})
+		o.size += file.Size()
+	}
+	sort.Slice(o.entries, func(i, j int) bool {
+		return o.entries[i].name < o.entries[j].name
+	})
+	return nil
+}
+
+// put persists the event along with the id it is dispatched with and returns the name of its entry.
+// The entry is dispatching until it is released.
+func (o *outbox) put(event *Event, eventID string, now time.Time) (string, error) {
+	data, err := json.Marshal(&outboxRecord{
+		Event:   event,
+		EventID: eventID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if dropped := o.prune(now, int64(len(data))); dropped > 0 {
+		outboxDroppedEvents.Add(int64(dropped))
+	}
+
+	// event source names are configmap keys, which are valid file names
+	name := fmt.Sprintf("%020d-%x-%s%s", now.UnixNano(), suuid.NewV1(), event.Name, outboxEntrySuffix)
+	if err := o.write(name, data); err != nil {
+		return "", err
+	}
+	entry := &outboxEntry{
+		name:        name,
+		eventSource: event.Name,
+		size:        int64(len(data)),
+		persistedAt: now,
+		dispatching: true,
+	}
+	// the entries are persisted concurrently by the event sources, so an entry is not necessarily the newest
+	i := sort.Search(len(o.entries), func(i int) bool {
+		return o.entries[i].name > name
+	})
+	o.entries = append(o.entries, nil)
+	copy(o.entries[i+1:], o.entries[i:])
+	o.entries[i] = entry
+	o.size += entry.size
+	return name, nil
+}
+
+// write writes the data to the file of the entry.
+// The data is written to a temporary file that is renamed once synced, so a crash never leaves a partial entry behind.
+func (o *outbox) write(name string, data []byte) error {
+	tmp, err := ioutil.TempFile(o.dir, outboxTempPrefix)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(o.dir, name)); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// get returns the record of the entry
+func (o *outbox) get(name string) (*outboxRecord, error) {
+	data, err := ioutil.ReadFile(filepath.Join(o.dir, name))
+	if err != nil {
+		return nil, err
+	}
+	var record outboxRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, err
+	}
+	if record.Event == nil {
+		return nil, fmt.Errorf("entry has no event")
+	}
+	return &record, nil
+}
+
+// update keeps the entry for the watchers the event is still to be delivered to, and releases it.
+// An entry dropped from the outbox in the meantime is not persisted again.
+func (o *outbox) update(name string, event *Event, eventID string, watchers []string) error {
+	data, err := json.Marshal(&outboxRecord{
+		Event:    event,
+		EventID:  eventID,
+		Watchers: watchers,
+	})
+	if err != nil {
+		return err
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	entry := o.find(name)
+	if entry == nil {
+		return nil
+	}
+	entry.dispatching = false
+	if err := o.write(name, data); err != nil {
+		return err
+	}
+	o.size += int64(len(data)) - entry.size
+	entry.size = int64(len(data))
+	return nil
+}
+
+// release marks the entry as no longer dispatching, so it is dispatched again when the outbox is replayed
+func (o *outbox) release(name string) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if entry := o.find(name); entry != nil {
+		entry.dispatching = false
+	}
+}
+
+// remove removes the entry from the outbox
+func (o *outbox) remove(name string) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	return o.removeEntry(name)
+}
+
+// removeEntry removes the entry from the outbox. It must be called with the lock held.
+func (o *outbox) removeEntry(name string) error {
+	if err := os.Remove(filepath.Join(o.dir, name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i, entry := range o.entries {
+		if entry.name == name {
+			o.size -= entry.size
+			o.entries = append(o.entries[:i], o.entries[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// find returns the entry of the name, nil if it is not in the outbox. It must be called with the lock held.
+func (o *outbox) find(name string) *outboxEntry {
+	i := sort.Search(len(o.entries), func(i int) bool {
+		return o.entries[i].name >= name
+	})
+	if i < len(o.entries) && o.entries[i].name == name {
+		return o.entries[i]
+	}
+	return nil
+}
+
+// list returns the entries of the outbox, the oldest first
+func (o *outbox) list() []outboxEntry {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	entries := make([]outboxEntry, 0, len(o.entries))
+	for _, entry := range o.entries {
+		entries = append(entries, *entry)
+	}
+	return entries
+}
+
+// claim returns the entries of the event source that are not dispatching, the oldest first, and marks them as dispatching
+func (o *outbox) claim(eventSource string) []outboxEntry {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	var entries []outboxEntry
+	for _, entry := range o.entries {
+		if entry.dispatching || entry.eventSource != eventSource {
+			continue
+		}
+		entry.dispatching = true
+		entries = append(entries, *entry)
+	}
+	return entries
+}
+
+// prune drops the events older than the max age, then the oldest events until an event of the incoming size fits in the outbox.
+// It returns the number of dropped events and must be called with the lock held.
+func (o *outbox) prune(now time.Time, incoming int64) int {
+	dropped := 0
+	for len(o.entries) > 0 {
+		entry := o.entries[0]
+		if now.Sub(entry.persistedAt) <= o.maxAge && o.size+incoming <= o.maxSize {
+			break
+		}
+		if err := o.removeEntry(entry.name); err != nil {
+			break
+		}
+		dropped++
+	}
+	return dropped
+}
+
+// outboxDelivery tracks the dispatch of an event of the outbox, along with the retries of the deliveries that failed.
+// Once they are done, the entry is removed if the event reached all its watchers. Otherwise the entry is kept with the watchers
+// the event is still to be delivered to, so only they receive the event when the outbox is replayed.
+type outboxDelivery struct {
+	outbox *outbox
+	// entry is the name of the entry of the event
+	entry string
+	// event is the event of the entry
+	event *Event
+	// eventID is the id of the CloudEvents event the event is dispatched as
+	eventID string
+	// watchers holds the names of the watchers the event is dispatched to. Empty for all the watchers.
+	watchers []string
+	// lock protects the state of the delivery, which is updated by the retries
+	lock sync.Mutex
+	// pending holds the names of the watchers the event is still to be delivered to
+	pending map[string]bool
+	// retrying is the number of deliveries of the event in the retry queue
+	retrying int
+	// dispatched is true once the dispatch of the event returned
+	dispatched bool
+	// failed is true if the event failed to be dispatched before any delivery. The entry is then kept as it is.
+	failed bool
+}
+
+// newOutboxDelivery returns the delivery of the event of the entry to the watchers
+func newOutboxDelivery(o *outbox, entry string, event *Event, eventID string, watchers []string) *outboxDelivery {
+	return &outboxDelivery{
+		outbox:   o,
+		entry:    entry,
+		event:    event,
+		eventID:  eventID,
+		watchers: watchers,
+		pending:  make(map[string]bool),
+	}
+}
+
+// targets returns true if the event is dispatched to the watcher
+func (d *outboxDelivery) targets(watcher string) bool {
+	if len(d.watchers) == 0 {
+		return true
+	}
+	for _, name := range d.watchers {
+		if name == watcher {
+			return true
+		}
+	}
+	return false
+}
+
+// retry records that the delivery of the event to the watcher failed and is queued to be retried
+func (d *outboxDelivery) retry(watcher string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.pending[watcher] = true
+	d.retrying++
+}
+
+// done records the end of the retries of the delivery of the event to the watcher. The event is still to be delivered to
+// the watcher if the retries were exhausted, but not if it was delivered or rejected.
+func (d *outboxDelivery) done(watcher string, pending bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if !pending {
+		delete(d.pending, watcher)
+	}
+	d.retrying--
+	d.settle()
+}
+
+// dispatchReturned records the outcome of the dispatch of the event
+func (d *outboxDelivery) dispatchReturned(err error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.dispatched = true
+	if err != nil && err != ErrEventFiltered {
+		if _, ok := err.(*deliveryError); !ok {
+			d.failed = true
+		}
+	}
+	d.settle()
+}
+
+// settle updates the entry once the dispatch and the retries of the event are done. It must be called with the lock held.
+func (d *outboxDelivery) settle() {
+	if !d.dispatched || d.retrying > 0 {
+		return
+	}
+	if d.failed {
+		d.outbox.release(d.entry)
+		return
+	}
+	if len(d.pending) == 0 {
+		if err := d.outbox.remove(d.entry); err != nil {
+			d.outbox.release(d.entry)
+		}
+		return
+	}
+	watchers := make([]string, 0, len(d.pending))
+	for name := range d.pending {
+		watchers = append(watchers, name)
+	}
+	sort.Strings(watchers)
+	if err := d.outbox.update(d.entry, d.event, d.eventID, watchers); err != nil {
+		d.outbox.release(d.entry)
+	}
+}
+
+// dispatchEvent dispatches the event to the watchers. With an outbox, the event is persisted first and kept until it is delivered
+// to all the watchers or filtered out, and a failed dispatch is retried from the outbox.
+func (gc *GatewayConfig) dispatchEvent(event *Event) error {
+	if gc.outbox == nil {
+		return gc.DispatchEvent(event)
+	}
+	// the event keeps the id and the time it is first dispatched with whenever it is dispatched from the outbox,
+	// so the watchers can tell it is the same event
+	now := time.Now().UTC()
+	if event.Time == nil {
+		if t, err := ptypes.TimestampProto(now); err == nil {
+			event.Time = t
+		}
+	}
+	eventID := newEventID()
+	entry, err := gc.outbox.put(event, eventID, now)
+	if err != nil {
+		// the event is still dispatched, it just can't be dispatched again if this attempt fails
+		gc.Log.Error().Err(err).Str("event-source-name", event.Name).Msg("failed to persist event in outbox")
+		return gc.DispatchEvent(event)
+	}
+	err = gc.dispatchOutboxEvent(entry, event, eventID, nil)
+	if err != nil && err != ErrEventFiltered {
+		// the outbox keeps the event until it is delivered, so the event source must not deliver it again
+		return &retriedError{err: err}
+	}
+	return err
+}
+
+// dispatchOutboxEvent dispatches the event of the outbox entry with its id to the watchers, only to the named watchers if any
+func (gc *GatewayConfig) dispatchOutboxEvent(entry string, event *Event, eventID string, watchers []string) error {
+	delivery := newOutboxDelivery(gc.outbox, entry, event, eventID, watchers)
+	err := gc.dispatch(event, delivery)
+	delivery.dispatchReturned(err)
+	return err
+}
+
+// replayOutbox dispatches the events of the event source left in the outbox, each to the watchers it is still to be delivered to.
+// It is called once the options of the event source are registered, so the events are transformed and filtered like new events.
+// The events are replayed alongside the new events of the event source, until the context of the event source is done.
+// The entries that are not replayed by then are released, so they are replayed when the event source runs again.
+func (gc *GatewayConfig) replayOutbox(ctx context.Context, eventSource string) {
+	entries := gc.outbox.claim(eventSource)
+	if len(entries) == 0 {
+		return
+	}
+	gc.Log.Info().Str("event-source-name", eventSource).Int("events", len(entries)).Msg("replaying events in outbox")
+	for i, entry := range entries {
+		select {
+		case <-ctx.Done():
+			for _, entry := range entries[i:] {
+				gc.outbox.release(entry.name)
+			}
+			gc.Log.Info().Str("event-source-name", eventSource).Int("events", len(entries)-i).Msg("event source is stopped, leaving events in outbox")
+			return
+		default:
+		}
+		if time.Since(entry.persistedAt) > gc.outbox.maxAge {
+			gc.Log.Warn().Str("entry", entry.name).Msg("event in outbox is too old, dropping it")
+			outboxDroppedEvents.Add(1)
+			if err := gc.outbox.remove(entry.name); err != nil {
+				gc.Log.Warn().Err(err).Str("entry", entry.name).Msg("failed to remove event from outbox")
+			}
+			continue
+		}
+		record, err := gc.outbox.get(entry.name)
+		if err != nil {
+			gc.Log.Error().Err(err).Str("entry", entry.name).Msg("failed to read event in outbox, dropping it")
+			outboxDroppedEvents.Add(1)
+			if err := gc.outbox.remove(entry.name); err != nil {
+				gc.Log.Warn().Err(err).Str("entry", entry.name).Msg("failed to remove event from outbox")
+			}
+			continue
+		}
+		if err := gc.dispatchOutboxEvent(entry.name, record.Event, record.EventID, record.Watchers); err != nil && err != ErrEventFiltered {
+			gc.Log.Error().Err(err).Str("entry", entry.name).Msg("failed to dispatch event in outbox, keeping it until it is dispatched")
+		}
+	}
+}
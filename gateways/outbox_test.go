@@ -0,0 +1,173 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateways
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	pc "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestOutbox(t *testing.T) {
+	convey.Convey("Given an outbox", t, func() {
+		dir, err := ioutil.TempDir("", "outbox")
+		convey.So(err, convey.ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		o, err := newOutbox(&v1alpha1.Outbox{
+			Path:    dir,
+			MaxSize: "1Ki",
+			MaxAge:  "1h",
+		})
+		convey.So(err, convey.ShouldBeNil)
+		now := time.Now().UTC()
+
+		convey.Convey("Persisted events must be listed in order and read back", func() {
+			first, err := o.put(&Event{Name: "webhook", Payload: []byte("first")}, "", now)
+			convey.So(err, convey.ShouldBeNil)
+			_, err = o.put(&Event{Name: "webhook", Payload: []byte("second")}, "", now.Add(time.Second))
+			convey.So(err, convey.ShouldBeNil)
+
+			entries := o.list()
+			convey.So(entries, convey.ShouldHaveLength, 2)
+			convey.So(entries[0].name, convey.ShouldEqual, first)
+
+			record, err := o.get(first)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(string(record.Event.Payload), convey.ShouldEqual, "first")
+
+			convey.So(o.remove(first), convey.ShouldBeNil)
+			entries = o.list()
+			convey.So(entries, convey.ShouldHaveLength, 1)
+		})
+
+		convey.Convey("Persisted events must be indexed again when the outbox is opened", func() {
+			_, err := o.put(&Event{Name: "webhook", Payload: []byte("first")}, "", now)
+			convey.So(err, convey.ShouldBeNil)
+
+			reopened, err := newOutbox(&v1alpha1.Outbox{Path: dir})
+			convey.So(err, convey.ShouldBeNil)
+			entries := reopened.list()
+			convey.So(entries, convey.ShouldHaveLength, 1)
+			convey.So(entries[0].dispatching, convey.ShouldBeFalse)
+			convey.So(entries[0].eventSource, convey.ShouldEqual, "webhook")
+			convey.So(reopened.size, convey.ShouldEqual, o.size)
+		})
+
+		convey.Convey("Old events must be dropped", func() {
+			_, err := o.put(&Event{Name: "webhook", Payload: []byte("old")}, "", now.Add(-2*time.Hour))
+			convey.So(err, convey.ShouldBeNil)
+			_, err = o.put(&Event{Name: "webhook", Payload: []byte("new")}, "", now)
+			convey.So(err, convey.ShouldBeNil)
+
+			entries := o.list()
+			convey.So(entries, convey.ShouldHaveLength, 1)
+		})
+
+		convey.Convey("The oldest events must be dropped once the outbox is full", func() {
+			payload := make([]byte, 300)
+			for i := 0; i < 5; i++ {
+				_, err := o.put(&Event{Name: "webhook", Payload: payload}, "", now.Add(time.Duration(i)*time.Second))
+				convey.So(err, convey.ShouldBeNil)
+			}
+			entries := o.list()
+			var total int64
+			for _, entry := range entries {
+				total += entry.size
+			}
+			convey.So(total, convey.ShouldBeLessThanOrEqualTo, 1024)
+			convey.So(entries[len(entries)-1].persistedAt.Equal(now.Add(4*time.Second)), convey.ShouldBeTrue)
+		})
+
+		convey.Convey("Events must stay in the outbox only for the watchers they failed to be delivered to", func() {
+			event := &Event{Name: "webhook", Payload: []byte("{}")}
+			name, err := o.put(event, "", now)
+			convey.So(err, convey.ShouldBeNil)
+
+			delivery := newOutboxDelivery(o, name, event, "", nil)
+			delivery.retry("sensor/delivered-sensor")
+			delivery.retry("sensor/failing-sensor")
+			delivery.dispatchReturned(&deliveryError{watchers: []string{"sensor/delivered-sensor", "sensor/failing-sensor"}})
+			delivery.done("sensor/delivered-sensor", false)
+			convey.So(o.list()[0].dispatching, convey.ShouldBeTrue)
+			delivery.done("sensor/failing-sensor", true)
+
+			entries := o.list()
+			convey.So(entries, convey.ShouldHaveLength, 1)
+			convey.So(entries[0].dispatching, convey.ShouldBeFalse)
+			record, err := o.get(name)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(record.Watchers, convey.ShouldResemble, []string{"sensor/failing-sensor"})
+
+			convey.Convey("The event must be removed once it is delivered to them", func() {
+				convey.So(o.claim("calendar"), convey.ShouldBeEmpty)
+				entries := o.claim("webhook")
+				convey.So(entries, convey.ShouldHaveLength, 1)
+				delivery := newOutboxDelivery(o, name, record.Event, record.EventID, record.Watchers)
+				convey.So(delivery.targets("sensor/delivered-sensor"), convey.ShouldBeFalse)
+				delivery.retry("sensor/failing-sensor")
+				delivery.dispatchReturned(&deliveryError{watchers: []string{"sensor/failing-sensor"}})
+				delivery.done("sensor/failing-sensor", false)
+				convey.So(o.list(), convey.ShouldBeEmpty)
+			})
+		})
+
+		convey.Convey("Events must stay in the outbox until they are dispatched", func() {
+			gc := getGatewayConfig()
+			gc.outbox = o
+
+			err := gc.dispatchEvent(&Event{Name: "webhook", Payload: []byte("{}")})
+			convey.So(err, convey.ShouldNotBeNil)
+			entries := o.list()
+			convey.So(entries, convey.ShouldHaveLength, 1)
+
+			record, err := o.get(entries[0].name)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(record.EventID, convey.ShouldNotBeEmpty)
+			convey.So(record.Event.Time, convey.ShouldNotBeNil)
+			ce, err := gc.transformEvent(record.Event, record.EventID)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ce.Context.EventID, convey.ShouldEqual, record.EventID)
+			eventTime, err := ptypes.Timestamp(record.Event.Time)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ce.Context.EventTime.Time.Equal(eventTime), convey.ShouldBeTrue)
+
+			convey.Convey("Events must be left in the outbox once the event source is stopped", func() {
+				gc.gw.Spec.EventProtocol.Type = pc.HTTP
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				gc.replayOutbox(ctx, "webhook")
+				entries := o.list()
+				convey.So(entries, convey.ShouldHaveLength, 1)
+				convey.So(entries[0].dispatching, convey.ShouldBeFalse)
+			})
+
+			convey.Convey("Events must be removed once they are replayed", func() {
+				gc.gw.Spec.EventProtocol.Type = pc.HTTP
+				gc.replayOutbox(context.Background(), "webhook")
+				convey.So(o.list(), convey.ShouldHaveLength, 0)
+			})
+		})
+	})
+}
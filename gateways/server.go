@@ -0,0 +1,93 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateways
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/argoproj/argo-events/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// serverReconnectMaxDelay is the maximum delay between the attempts to reconnect to the gateway server
+const serverReconnectMaxDelay = 30 * time.Second
+
+// HasRemoteServer returns true if the gateway server runs outside of the gateway pod
+func (gc *GatewayConfig) HasRemoteServer() bool {
+	return gc.gw.Spec.Server != nil
+}
+
+// serverAddress returns the address of the gateway server
+func (gc *GatewayConfig) serverAddress() string {
+	if gc.HasRemoteServer() {
+		return gc.gw.Spec.Server.Address
+	}
+	return fmt.Sprintf("localhost:%s", gc.serverPort)
+}
+
+// serverDialOptions returns the options of the connections to the gateway server.
+// The connections are established in the background and re-established with backoff whenever they break.
+func (gc *GatewayConfig) serverDialOptions() ([]grpc.DialOption, error) {
+	options := []grpc.DialOption{
+		grpc.WithBackoffMaxDelay(serverReconnectMaxDelay),
+	}
+	if !gc.HasRemoteServer() || gc.gw.Spec.Server.TLS == nil {
+		return append(options, grpc.WithInsecure()), nil
+	}
+
+	serverTLS := gc.gw.Spec.Server.TLS
+	tlsConfig := &tls.Config{
+		ServerName: serverTLS.ServerName,
+	}
+	if serverTLS.CACertSecret != nil {
+		caCert, err := gc.getSecret(serverTLS.CACertSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get CA certificate of gateway server. err: %+v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, fmt.Errorf("failed to parse CA certificate of gateway server")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if serverTLS.ClientCertSecret != nil && serverTLS.ClientKeySecret != nil {
+		clientCert, err := gc.getSecret(serverTLS.ClientCertSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get client certificate. err: %+v", err)
+		}
+		clientKey, err := gc.getSecret(serverTLS.ClientKeySecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get client key. err: %+v", err)
+		}
+		certificate, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse client certificate. err: %+v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return append(options, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))), nil
+}
+
+// getSecret returns the value of the secret key in the namespace of the gateway
+func (gc *GatewayConfig) getSecret(selector *corev1.SecretKeySelector) (string, error) {
+	return store.GetSecrets(gc.Clientset, gc.Namespace, selector.Name, selector.Key)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateways
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// selfSignedCertificate returns a PEM encoded self signed certificate and its key
+func selfSignedCertificate() ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "gateway-client"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), nil
+}
+
+func secretKey(name, key string) *corev1.SecretKeySelector {
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: name,
+		},
+		Key: key,
+	}
+}
+
+func TestServerConnection(t *testing.T) {
+	convey.Convey("Given a gateway", t, func() {
+		gc := getGatewayConfig()
+
+		convey.Convey("The gateway server of the gateway pod must be connected to insecurely", func() {
+			convey.So(gc.HasRemoteServer(), convey.ShouldBeFalse)
+			convey.So(gc.serverAddress(), convey.ShouldEqual, "localhost:1234")
+			options, err := gc.serverDialOptions()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(options, convey.ShouldHaveLength, 2)
+		})
+
+		convey.Convey("A remote gateway server must be connected to over mutual TLS", func() {
+			cert, key, err := selfSignedCertificate()
+			convey.So(err, convey.ShouldBeNil)
+			_, err = gc.Clientset.CoreV1().Secrets(gc.Namespace).Create(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway-client-tls",
+					Namespace: gc.Namespace,
+				},
+				Data: map[string][]byte{
+					"ca.crt":  cert,
+					"tls.crt": cert,
+					"tls.key": key,
+				},
+			})
+			convey.So(err, convey.ShouldBeNil)
+
+			gc.gw.Spec.Server = &v1alpha1.GatewayServer{
+				Address: "calendar-gateway-server.event-sources.svc:9330",
+				TLS: &v1alpha1.GatewayServerTLS{
+					CACertSecret:     secretKey("gateway-client-tls", "ca.crt"),
+					ClientCertSecret: secretKey("gateway-client-tls", "tls.crt"),
+					ClientKeySecret:  secretKey("gateway-client-tls", "tls.key"),
+				},
+			}
+			convey.So(gc.HasRemoteServer(), convey.ShouldBeTrue)
+			convey.So(gc.serverAddress(), convey.ShouldEqual, "calendar-gateway-server.event-sources.svc:9330")
+			options, err := gc.serverDialOptions()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(options, convey.ShouldHaveLength, 2)
+
+			convey.Convey("An invalid CA certificate must be rejected", func() {
+				gc.gw.Spec.Server.TLS.CACertSecret = secretKey("gateway-client-tls", "tls.key")
+				_, err := gc.serverDialOptions()
+				convey.So(err, convey.ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -39,6 +39,12 @@ type EventSourceStatus struct {
 	Phase v1alpha1.NodePhase
 	// Gateway reference
 	Gw *v1alpha1.Gateway
+	// Delivery holds the delivery outcomes of the event source since the last update
+	Delivery *v1alpha1.DeliveryStatus
+	// RestartCount is the number of times the event source was restarted after failing
+	RestartCount int32
+	// LastError is the error of the latest failure of the event source
+	LastError string
 }
 
 // markGatewayNodePhase marks the node with a phase, returns the node
@@ -54,11 +60,36 @@ func (gc *GatewayConfig) markGatewayNodePhase(nodeStatus *EventSourceStatus) *v1
 		node.Phase = nodeStatus.Phase
 	}
 	node.Message = nodeStatus.Message
+	if nodeStatus.LastError != "" {
+		node.LastError = nodeStatus.LastError
+		node.RestartCount = nodeStatus.RestartCount
+	}
 	gc.gw.Status.Nodes[node.ID] = *node
 	gc.updated = true
 	return node
 }
 
+// updateNodeDelivery adds the delivery outcomes of the event source to its node
+func (gc *GatewayConfig) updateNodeDelivery(status *EventSourceStatus) {
+	node := gc.getNodeByID(status.Id)
+	if node == nil {
+		gc.Log.Warn().Str("node-name", status.Name).Str("node-id", status.Id).Msg("node is not initialized, discarding delivery status")
+		return
+	}
+	if node.Delivery == nil {
+		node.Delivery = &v1alpha1.DeliveryStatus{}
+	}
+	node.Delivery.Delivered += status.Delivery.Delivered
+	node.Delivery.Failed += status.Delivery.Failed
+	node.Delivery.Filtered += status.Delivery.Filtered
+	if status.Delivery.LastError != "" {
+		node.Delivery.LastError = status.Delivery.LastError
+		node.Delivery.LastFailureTime = status.Delivery.LastFailureTime
+	}
+	gc.gw.Status.Nodes[node.ID] = *node
+	gc.updated = true
+}
+
 // getNodeByName returns the node from this gateway for the nodeName
 func (gc *GatewayConfig) getNodeByID(nodeID string) *v1alpha1.NodeStatus {
 	node, ok := gc.gw.Status.Nodes[nodeID]
@@ -106,6 +137,9 @@ func (gc *GatewayConfig) UpdateGatewayResourceState(status *EventSourceStatus) {
 	case v1alpha1.NodePhaseResourceUpdate:
 		gc.gw = status.Gw
 
+	case v1alpha1.NodePhaseDeliveryUpdate:
+		gc.updateNodeDelivery(status)
+
 	case v1alpha1.NodePhaseRemove:
 		delete(gc.gw.Status.Nodes, status.Id)
 		gc.Log.Info().Str("event-source-name", status.Name).Msg("event source is removed")
@@ -131,6 +165,12 @@ func (gc *GatewayConfig) UpdateGatewayResourceState(status *EventSourceStatus) {
 		gc.gw = updatedGw
 		labels[common.LabelEventType] = string(eventType)
 
+		// delivery updates are periodic, only failures to persist them are worth a K8s event
+		if status.Phase == v1alpha1.NodePhaseDeliveryUpdate && eventType != common.EscalationEventType {
+			gc.updated = false
+			return
+		}
+
 		// generate a K8s event for persist event source state change
 		if err := common.GenerateK8sEvent(gc.Clientset, status.Message, eventType, "event source state update", gc.Name, gc.Namespace, gc.controllerInstanceID, gateway.Kind, labels); err != nil {
 			gc.Log.Error().Err(err).Str("event-source-name", status.Name).Msg("failed to create K8s event to log event source state change")
@@ -0,0 +1,192 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateways
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/ghodss/yaml"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// PayloadFormat is the format of an event payload converted to JSON
+type PayloadFormat string
+
+// possible payload formats
+const (
+	// YAMLPayload is a YAML document
+	YAMLPayload PayloadFormat = "yaml"
+	// FormPayload is an URL encoded form
+	FormPayload PayloadFormat = "form"
+)
+
+// PayloadTransform is a step of the transformation of the events of an event source before they are dispatched.
+// Exactly one of the fields is set.
+type PayloadTransform struct {
+	// Convert converts the payload from the format to JSON
+	Convert PayloadFormat `json:"convert,omitempty"`
+	// Extract replaces the payload with the value at the path
+	Extract string `json:"extract,omitempty"`
+	// Drop removes the values at the paths from the payload
+	Drop []string `json:"drop,omitempty"`
+	// Rename moves the values at the paths, the keys of the map, to the new paths, the values of the map
+	Rename map[string]string `json:"rename,omitempty"`
+	// Extensions adds the static extensions to the context of the event
+	Extensions map[string]string `json:"extensions,omitempty"`
+}
+
+// validate validates the transform step
+func (t *PayloadTransform) validate() error {
+	steps := 0
+	if t.Convert != "" {
+		switch t.Convert {
+		case YAMLPayload, FormPayload:
+		default:
+			return fmt.Errorf("unknown payload format %s", t.Convert)
+		}
+		steps++
+	}
+	if t.Extract != "" {
+		steps++
+	}
+	if len(t.Drop) > 0 {
+		steps++
+	}
+	if len(t.Rename) > 0 {
+		steps++
+	}
+	if len(t.Extensions) > 0 {
+		steps++
+	}
+	if steps != 1 {
+		return fmt.Errorf("transform must have exactly one of convert, extract, drop, rename or extensions")
+	}
+	return nil
+}
+
+// applyTransforms applies the transforms to the event, in order
+func applyTransforms(event *apicommon.Event, transforms []PayloadTransform) error {
+	for i, t := range transforms {
+		if err := t.apply(event); err != nil {
+			return fmt.Errorf("failed to apply transform %d. err: %+v", i, err)
+		}
+	}
+	return nil
+}
+
+// apply applies the transform step to the event
+func (t *PayloadTransform) apply(event *apicommon.Event) error {
+	if t.Convert != "" {
+		data, err := convertPayload(event.Payload, t.Convert)
+		if err != nil {
+			return err
+		}
+		event.Payload = data
+		event.Context.ContentType = "application/json"
+		return nil
+	}
+
+	if len(t.Extensions) > 0 {
+		// the extensions of the event may be shared with the event received from the event source
+		extensions := make(map[string]string, len(event.Context.Extensions)+len(t.Extensions))
+		for name, value := range event.Context.Extensions {
+			extensions[name] = value
+		}
+		for name, value := range t.Extensions {
+			extensions[name] = value
+		}
+		event.Context.Extensions = extensions
+		return nil
+	}
+
+	if !json.Valid(event.Payload) {
+		return fmt.Errorf("payload is not valid JSON")
+	}
+	js := event.Payload
+	var err error
+	switch {
+	case t.Extract != "":
+		res := gjson.GetBytes(js, t.Extract)
+		if !res.Exists() {
+			return fmt.Errorf("path %s doesn't exist in the payload", t.Extract)
+		}
+		js = []byte(res.Raw)
+
+	case len(t.Drop) > 0:
+		for _, path := range t.Drop {
+			if js, err = sjson.DeleteBytes(js, path); err != nil {
+				return fmt.Errorf("failed to drop %s. err: %+v", path, err)
+			}
+		}
+
+	case len(t.Rename) > 0:
+		// renames are applied in the order of their paths so the result doesn't depend on the order of the map
+		paths := make([]string, 0, len(t.Rename))
+		for path := range t.Rename {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			res := gjson.GetBytes(js, path)
+			if !res.Exists() {
+				continue
+			}
+			if js, err = sjson.DeleteBytes(js, path); err != nil {
+				return fmt.Errorf("failed to rename %s. err: %+v", path, err)
+			}
+			if js, err = sjson.SetRawBytes(js, t.Rename[path], []byte(res.Raw)); err != nil {
+				return fmt.Errorf("failed to rename %s to %s. err: %+v", path, t.Rename[path], err)
+			}
+		}
+	}
+	event.Payload = js
+	return nil
+}
+
+// convertPayload converts the payload from the format to JSON
+func convertPayload(payload []byte, format PayloadFormat) ([]byte, error) {
+	switch format {
+	case YAMLPayload:
+		data, err := yaml.YAMLToJSON(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert yaml payload to JSON. err: %+v", err)
+		}
+		return data, nil
+	case FormPayload:
+		values, err := url.ParseQuery(string(payload))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse form payload. err: %+v", err)
+		}
+		// fields with a single value are strings, the others lists of strings
+		form := make(map[string]interface{}, len(values))
+		for name, value := range values {
+			if len(value) == 1 {
+				form[name] = value[0]
+				continue
+			}
+			form[name] = value
+		}
+		return json.Marshal(form)
+	default:
+		return nil, fmt.Errorf("unknown payload format %s", format)
+	}
+}
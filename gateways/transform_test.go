@@ -0,0 +1,123 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateways
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/tidwall/gjson"
+)
+
+var transformedEventSource = `
+endpoint: "/push"
+method: "POST"
+transforms:
+  - convert: yaml
+  - extract: body
+  - drop:
+      - sender
+  - rename:
+      head_commit.message: message
+  - extensions:
+      team: payments
+`
+
+func TestTransforms(t *testing.T) {
+	convey.Convey("Given a gateway", t, func() {
+		gc := getGatewayConfig()
+
+		convey.Convey("The transforms of the event source must be parsed out of its configuration", func() {
+			options, err := parseEventSourceOptions(transformedEventSource)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(options.Transforms, convey.ShouldHaveLength, 5)
+
+			options, err = parseEventSourceOptions(`endpoint: "/push"`)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(options.Transforms, convey.ShouldBeEmpty)
+
+			_, err = parseEventSourceOptions(`
+transforms:
+  - extract: body
+    drop:
+      - sender
+`)
+			convey.So(err, convey.ShouldNotBeNil)
+
+			_, err = parseEventSourceOptions(`
+transforms:
+  - convert: xml
+`)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("The event must be transformed before it is dispatched", func() {
+			options, err := parseEventSourceOptions(transformedEventSource)
+			convey.So(err, convey.ShouldBeNil)
+			gc.setEventSourceOptions("push", options)
+
+			ce, err := gc.transformEvent(&Event{
+				Name: "push",
+				Payload: []byte(`
+body:
+  sender: bot
+  ref: refs/heads/master
+  head_commit:
+    message: fix typo
+`),
+				ContentType: "application/yaml",
+				Attributes: map[string]string{
+					"x-github-event": "push",
+				},
+			}, "")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(gjson.GetBytes(ce.Payload, "ref").String(), convey.ShouldEqual, "refs/heads/master")
+			convey.So(gjson.GetBytes(ce.Payload, "message").String(), convey.ShouldEqual, "fix typo")
+			convey.So(gjson.GetBytes(ce.Payload, "sender").Exists(), convey.ShouldBeFalse)
+			convey.So(gjson.GetBytes(ce.Payload, "head_commit.message").Exists(), convey.ShouldBeFalse)
+			convey.So(ce.Context.ContentType, convey.ShouldEqual, "application/json")
+			convey.So(ce.Context.Extensions, convey.ShouldResemble, map[string]string{
+				"xgithubevent": "push",
+				"team":         "payments",
+			})
+
+			convey.Convey("An event that can't be transformed must fail", func() {
+				_, err := gc.transformEvent(&Event{
+					Name:    "push",
+					Payload: []byte(`ref: refs/heads/master`),
+				}, "")
+				convey.So(err, convey.ShouldNotBeNil)
+			})
+
+			convey.Convey("The transforms must be removed with the event source", func() {
+				gc.setEventSourceOptions("push", nil)
+				ce, err := gc.transformEvent(&Event{
+					Name:    "push",
+					Payload: []byte(`{"sender":"bot"}`),
+				}, "")
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(string(ce.Payload), convey.ShouldEqual, `{"sender":"bot"}`)
+			})
+		})
+
+		convey.Convey("A form must be converted to JSON", func() {
+			data, err := convertPayload([]byte("name=foo&tag=a&tag=b"), FormPayload)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(string(data), convey.ShouldEqual, `{"name":"foo","tag":["a","b"]}`)
+		})
+	})
+}
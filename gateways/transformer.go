@@ -18,14 +18,18 @@ package gateways
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/argoproj/argo-events/common"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	pc "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/golang/protobuf/ptypes"
 	suuid "github.com/satori/go.uuid"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -38,21 +42,62 @@ type TransformerPayload struct {
 	Payload []byte `json:"payload"`
 }
 
-// DispatchEvent dispatches event to gateway transformer for further processing
+// DispatchEvent dispatches event to gateway transformer for further processing.
+// It returns ErrEventFiltered if the event is dropped by the filters of its event source, or is not routed to any watcher.
 func (gc *GatewayConfig) DispatchEvent(gatewayEvent *Event) error {
-	transformedEvent, err := gc.transformEvent(gatewayEvent)
+	return gc.dispatch(gatewayEvent, nil)
+}
+
+// dispatch dispatches the event, tracking its delivery if the event is in the outbox.
+// An event of the outbox is dispatched with its id, and only to the watchers it is still to be delivered to if any.
+func (gc *GatewayConfig) dispatch(gatewayEvent *Event, delivery *outboxDelivery) error {
+	eventID := ""
+	if delivery != nil {
+		eventID = delivery.eventID
+	}
+	transformedEvent, err := gc.transformEvent(gatewayEvent, eventID)
 	if err != nil {
 		return err
 	}
 
-	payload, err := json.Marshal(transformedEvent)
+	if !gc.filterEvent(gatewayEvent.Name, transformedEvent) {
+		gc.Log.Info().Str("event-source", gatewayEvent.Name).Msg("event is filtered out, not dispatching it")
+		filteredEvents.Add(gatewayEvent.Name, 1)
+		return ErrEventFiltered
+	}
+
+	// nats messages have no headers, so events are always structured over nats
+	mode := gc.gw.Spec.EventProtocol.CloudEventsMode
+	if gc.gw.Spec.EventProtocol.Type == pc.NATS {
+		mode = pc.Structured
+	}
+	header, payload, err := common.EncodeCloudEvent(transformedEvent, gc.gw.Spec.EventProtocol.CloudEventsVersion, mode)
 	if err != nil {
-		return fmt.Errorf("failed to dispatch event to watchers over http. marshalling failed. err: %+v", err)
+		return fmt.Errorf("failed to dispatch event to watchers. encoding failed. err: %+v", err)
 	}
 
 	switch gc.gw.Spec.EventProtocol.Type {
 	case pc.HTTP:
-		if err = gc.dispatchEventOverHttp(transformedEvent.Context.Source.Host, payload); err != nil {
+		watchers := gc.routeEvent(gatewayEvent.Name, transformedEvent)
+		if len(watchers) == 0 && len(gc.httpWatchers()) > 0 {
+			gc.Log.Info().Str("event-source", gatewayEvent.Name).Msg("event is not routed to any watcher, not dispatching it")
+			filteredEvents.Add(gatewayEvent.Name, 1)
+			return ErrEventFiltered
+		}
+		if delivery != nil {
+			var targets []watcher
+			for _, w := range watchers {
+				if delivery.targets(w.name) {
+					targets = append(targets, w)
+				}
+			}
+			if len(targets) == 0 {
+				gc.Log.Info().Str("event-source", gatewayEvent.Name).Msg("event is no longer routed to the watchers it is still to be delivered to")
+				return nil
+			}
+			watchers = targets
+		}
+		if err = gc.dispatchEventOverHttp(transformedEvent.Context.Source.Host, watchers, header, payload, delivery); err != nil {
 			return err
 		}
 	case pc.NATS:
@@ -66,50 +111,187 @@ func (gc *GatewayConfig) DispatchEvent(gatewayEvent *Event) error {
 }
 
 // transformEvent transforms an event from event source into a CloudEvents specification compliant event
-// See https://github.com/cloudevents/spec for more info.
-func (gc *GatewayConfig) transformEvent(gatewayEvent *Event) (*apicommon.Event, error) {
+// with the given id, generating one if empty. See https://github.com/cloudevents/spec for more info.
+func (gc *GatewayConfig) transformEvent(gatewayEvent *Event, eventID string) (*apicommon.Event, error) {
 	// Generate an event id
-	eventId := suuid.NewV1()
+	if eventID == "" {
+		eventID = newEventID()
+	}
 
 	gc.Log.Info().Str("source", gatewayEvent.Name).
 		Msg("converting gateway event into cloudevents specification compliant event")
 
+	// the event source may not know the content type or the time of the event
+	contentType := gatewayEvent.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	eventTime := time.Now().UTC()
+	if gatewayEvent.Time != nil {
+		t, err := ptypes.Timestamp(gatewayEvent.Time)
+		if err != nil {
+			gc.Log.Warn().Err(err).Str("source", gatewayEvent.Name).Msg("invalid event time, using the current time")
+		} else {
+			eventTime = t.UTC()
+		}
+	}
+
+	cloudEventsVersion := common.CloudEventsVersion
+	if gc.gw.Spec.EventProtocol.CloudEventsVersion != "" {
+		cloudEventsVersion = string(gc.gw.Spec.EventProtocol.CloudEventsVersion)
+	}
+
 	// Create an CloudEvent
 	ce := &apicommon.Event{
 		Context: apicommon.EventContext{
-			CloudEventsVersion: common.CloudEventsVersion,
-			EventID:            fmt.Sprintf("%x", eventId),
-			ContentType:        "application/json",
-			EventTime:          metav1.MicroTime{Time: time.Now().UTC()},
+			CloudEventsVersion: cloudEventsVersion,
+			EventID:            eventID,
+			ContentType:        contentType,
+			EventTime:          metav1.MicroTime{Time: eventTime},
 			EventType:          gc.gw.Spec.Type,
 			EventTypeVersion:   gc.gw.Spec.EventVersion,
 			Source: &apicommon.URI{
 				Host: common.DefaultGatewayConfigurationName(gc.gw.Name, gatewayEvent.Name),
 			},
+			Extensions: gatewayEvent.Attributes,
 		},
 		Payload: gatewayEvent.Payload,
 	}
 
+	if options := gc.getEventSourceOptions(gatewayEvent.Name); options != nil && len(options.Transforms) > 0 {
+		if err := applyTransforms(ce, options.Transforms); err != nil {
+			return nil, fmt.Errorf("failed to transform event of event source %s. err: %+v", gatewayEvent.Name, err)
+		}
+	}
+	ce.Context.Extensions = gc.normalizeExtensions(gatewayEvent.Name, ce.Context.Extensions)
+
 	gc.Log.Info().Str("event-source", gatewayEvent.Name).Msg("event has been transformed into cloud event")
 	return ce, nil
 }
 
-// dispatchEventOverHttp dispatches event to watchers over http.
-func (gc *GatewayConfig) dispatchEventOverHttp(source string, eventPayload []byte) error {
-	gc.Log.Info().Str("source", source).Msg("dispatching event to watchers")
+// newEventID returns a new id for a CloudEvents event
+func newEventID() string {
+	return fmt.Sprintf("%x", suuid.NewV1())
+}
 
-	for _, sensor := range gc.gw.Spec.Watchers.Sensors {
-		if err := gc.postCloudEventToWatcher(common.DefaultServiceName(sensor.Name), gc.gw.Spec.EventProtocol.Http.Port, common.SensorServiceEndpoint, eventPayload); err != nil {
-			gc.Log.Warn().Str("event-source", source).Str("sensor-name", sensor.Name).Err(err).Msg("failed to dispatch event to sensor watcher over http. communication error")
+// normalizeExtensions names the extensions of the event as CloudEvents 1.0 attributes, so the filters of the watchers see the same
+// extensions whichever version of CloudEvents the events are dispatched in. An extension whose name is reduced to the name of another
+// extension is dropped, the extension already named as an attribute winning.
+func (gc *GatewayConfig) normalizeExtensions(eventSource string, extensions map[string]string) map[string]string {
+	if len(extensions) == 0 {
+		return extensions
+	}
+	names := make([]string, 0, len(extensions))
+	for name := range extensions {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		iNormal, jNormal := common.ExtensionName(names[i]) == names[i], common.ExtensionName(names[j]) == names[j]
+		if iNormal != jNormal {
+			return iNormal
+		}
+		return names[i] < names[j]
+	})
+
+	normalized := make(map[string]string, len(extensions))
+	for _, name := range names {
+		attribute := common.ExtensionName(name)
+		if attribute == "" {
+			gc.Log.Warn().Str("event-source", eventSource).Str("extension", name).Msg("extension has no valid attribute name, dropping it")
+			continue
 		}
+		if _, ok := normalized[attribute]; ok {
+			gc.Log.Warn().Str("event-source", eventSource).Str("extension", name).Str("attribute", attribute).Msg("extension collides with another extension, dropping it")
+			continue
+		}
+		normalized[attribute] = extensions[name]
 	}
-	for _, gateway := range gc.gw.Spec.Watchers.Gateways {
-		if err := gc.postCloudEventToWatcher(common.DefaultServiceName(gateway.Name), gateway.Port, gateway.Endpoint, eventPayload); err != nil {
-			gc.Log.Warn().Str("event-source", source).Str("gateway-name", gateway.Name).Err(err).Msg("failed to dispatch event to gateway watcher over http. communication error")
+	return normalized
+}
+
+// filterEvent applies the filters of the event source to the event, returning false if the event must be dropped.
+// The filters have the semantics of the filters of sensor dependencies. An event the filters fail to apply to is dispatched,
+// leaving the decision to the watchers.
+func (gc *GatewayConfig) filterEvent(name string, event *apicommon.Event) bool {
+	options := gc.getEventSourceOptions(name)
+	if options == nil || options.Filters == nil {
+		return true
+	}
+	ok, err := common.FilterEvent(*options.Filters, event)
+	if err != nil {
+		gc.Log.Warn().Err(err).Str("event-source", name).Msg("failed to apply filters to event, dispatching it")
+		return true
+	}
+	return ok
+}
+
+// dispatchEventOverHttp dispatches event to the watchers it is routed to over http.
+// The event is delivered to the watchers in parallel. Deliveries that still fail after retries are queued to be retried later,
+// tracked by the outbox delivery if the event is in the outbox.
+func (gc *GatewayConfig) dispatchEventOverHttp(source string, watchers []watcher, header http.Header, eventPayload []byte, delivery *outboxDelivery) error {
+	gc.Log.Info().Str("source", source).Int("watchers", len(watchers)).Msg("dispatching event to watchers")
+
+	errs := make([]error, len(watchers))
+	var wg sync.WaitGroup
+	for i, w := range watchers {
+		wg.Add(1)
+		go func(i int, w watcher) {
+			defer wg.Done()
+			errs[i] = gc.deliverEvent(w, header, eventPayload)
+		}(i, w)
+	}
+	wg.Wait()
+
+	var failed []string
+	var retries []*pendingDelivery
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		gc.Log.Warn().Str("event-source", source).Str("watcher", watchers[i].name).Err(err).Msg("failed to dispatch event to watcher over http")
+		failed = append(failed, watchers[i].name)
+		if isRetryableDelivery(err) {
+			retries = append(retries, &pendingDelivery{
+				watcher:  watchers[i],
+				header:   header,
+				payload:  eventPayload,
+				attempts: 1,
+				outbox:   delivery,
+			})
 		}
 	}
-	gc.Log.Info().Msg("successfully dispatched event to all watchers")
-	return nil
+	if len(failed) == 0 {
+		gc.Log.Info().Msg("successfully dispatched event to all watchers")
+		return nil
+	}
+
+	// deliveries rejected by the watchers are final, the others are retried from the retry queue if they all fit in it
+	if len(retries) == 0 {
+		return &deliveryError{watchers: failed, rejected: true}
+	}
+	var settlement *retrySettlement
+	if delivery != nil {
+		for _, retry := range retries {
+			delivery.retry(retry.watcher.name)
+		}
+	} else {
+		settlement = newRetrySettlement(len(retries))
+		for _, retry := range retries {
+			retry.settlement = settlement
+		}
+	}
+	if !gc.queueRetries(retries) {
+		if delivery != nil {
+			for _, retry := range retries {
+				delivery.done(retry.watcher.name, true)
+			}
+		}
+		return &deliveryError{watchers: failed}
+	}
+	if settlement != nil {
+		return &deliveryError{watchers: failed, settled: settlement.settled}
+	}
+	return &deliveryError{watchers: failed}
 }
 
 // dispatchEventOverNats dispatches event over nats
@@ -131,14 +313,26 @@ func (gc *GatewayConfig) dispatchEventOverNats(source string, eventPayload []byt
 	return nil
 }
 
-// postCloudEventToWatcher makes a HTTP POST call to watcher's service
-func (gc *GatewayConfig) postCloudEventToWatcher(host string, port string, endpoint string, payload []byte) error {
-	req, err := http.NewRequest("POST", fmt.Sprintf("http://%s:%s%s", host, port, endpoint), bytes.NewBuffer(payload))
+// postCloudEventToWatcher makes a HTTP POST call to watcher's service. A non 2xx response is a failure.
+// The header carries the content type of the payload and, in the binary mode of CloudEvents 1.0, the attributes of the event.
+func (gc *GatewayConfig) postCloudEventToWatcher(url string, header http.Header, payload []byte) error {
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payload))
 	if err != nil {
 		return err
 	}
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-	return err
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	resp, err := gc.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	// drain the body so the connection is reused
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &watcherResponseError{statusCode: resp.StatusCode}
+	}
+	return nil
 }
@@ -0,0 +1,124 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateways
+
+import (
+	"testing"
+	"time"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	sv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestTransformEvent(t *testing.T) {
+	convey.Convey("Given a gateway", t, func() {
+		gc := getGatewayConfig()
+
+		convey.Convey("The metadata of the event must be mapped into the context of the cloud event", func() {
+			occurred := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+			ce, err := gc.transformEvent(&Event{
+				Name:        "kafka",
+				Payload:     []byte("<order/>"),
+				ContentType: "application/xml",
+				Time:        EventTime(occurred),
+				Attributes: map[string]string{
+					"kafkakey": "order-1",
+				},
+			}, "")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ce.Context.ContentType, convey.ShouldEqual, "application/xml")
+			convey.So(ce.Context.EventTime.Time.Equal(occurred), convey.ShouldBeTrue)
+			convey.So(ce.Context.Extensions["kafkakey"], convey.ShouldEqual, "order-1")
+		})
+
+		convey.Convey("The attributes of the event must be named as CloudEvents 1.0 attributes whichever the version", func() {
+			ce, err := gc.transformEvent(&Event{
+				Name:    "webhook",
+				Payload: []byte("{}"),
+				Attributes: map[string]string{
+					"x-github-event": "push",
+					"X-Request-Id":   "1234",
+					"xrequestid":     "5678",
+					"--":             "dropped",
+				},
+			}, "")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ce.Context.Extensions, convey.ShouldResemble, map[string]string{
+				"xgithubevent": "push",
+				"xrequestid":   "5678",
+			})
+		})
+
+		convey.Convey("An event without metadata must default to a json payload received now", func() {
+			before := time.Now().UTC()
+			ce, err := gc.transformEvent(&Event{
+				Name:    "calendar",
+				Payload: []byte("{}"),
+			}, "")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ce.Context.ContentType, convey.ShouldEqual, "application/json")
+			convey.So(ce.Context.EventTime.Time.Before(before), convey.ShouldBeFalse)
+			convey.So(ce.Context.Extensions, convey.ShouldBeEmpty)
+		})
+	})
+}
+
+func TestDispatchFilteredEvent(t *testing.T) {
+	convey.Convey("Given an event source filtering out the events of bots", t, func() {
+		gc := getGatewayConfig()
+		gc.gw.Spec.EventProtocol.Type = apicommon.HTTP
+		gc.setEventSourceOptions("push", &eventSourceOptions{
+			Filters: &sv1alpha1.EventDependencyFilter{
+				Data: &sv1alpha1.Data{
+					Filters: []*sv1alpha1.DataFilter{
+						{
+							Path:  "sender.type",
+							Type:  sv1alpha1.JSONTypeString,
+							Value: "User",
+						},
+					},
+				},
+			},
+		})
+
+		convey.Convey("The events passing the filters must be dispatched", func() {
+			err := gc.DispatchEvent(&Event{
+				Name:    "push",
+				Payload: []byte(`{"sender":{"type":"User"}}`),
+			})
+			convey.So(err, convey.ShouldBeNil)
+		})
+
+		convey.Convey("The other events must be dropped", func() {
+			err := gc.DispatchEvent(&Event{
+				Name:    "push",
+				Payload: []byte(`{"sender":{"type":"Bot"}}`),
+			})
+			convey.So(err, convey.ShouldEqual, ErrEventFiltered)
+		})
+
+		convey.Convey("The events the filters can't be applied to must be dispatched", func() {
+			err := gc.DispatchEvent(&Event{
+				Name:        "push",
+				Payload:     []byte("ping"),
+				ContentType: "text/plain",
+			})
+			convey.So(err, convey.ShouldBeNil)
+		})
+	})
+}
@@ -36,6 +36,28 @@ const (
 	Streaming NatsType = "Streaming"
 )
 
+// CloudEventsSpecVersion is the version of the CloudEvents specification events are dispatched with
+type CloudEventsSpecVersion string
+
+// possible versions of the CloudEvents specification
+const (
+	// CloudEventsV01 is the event envelope of CloudEvents 0.1
+	CloudEventsV01 CloudEventsSpecVersion = "0.1"
+	// CloudEventsV1 is CloudEvents 1.0
+	CloudEventsV1 CloudEventsSpecVersion = "1.0"
+)
+
+// CloudEventsMode is the content mode of CloudEvents 1.0 events sent over HTTP
+type CloudEventsMode string
+
+// possible content modes of CloudEvents 1.0 events
+const (
+	// Binary mode carries the attributes of the event in HTTP headers and its data in the body
+	Binary CloudEventsMode = "binary"
+	// Structured mode carries the whole event as JSON in the body
+	Structured CloudEventsMode = "structured"
+)
+
 // Event is a data record expressing an occurrence and its context.
 // Adheres to the CloudEvents v0.1 specification
 type Event struct {
@@ -21,6 +21,8 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 
+import v1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+
 import v11 "k8s.io/api/core/v1"
 
 import github_com_argoproj_argo_events_pkg_apis_common "github.com/argoproj/argo-events/pkg/apis/common"
@@ -43,10 +45,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+func (m *DeliveryStatus) Reset()      { *m = DeliveryStatus{} }
+func (*DeliveryStatus) ProtoMessage() {}
+func (*DeliveryStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{0}
+}
+func (m *DeliveryStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliveryStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *DeliveryStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveryStatus.Merge(dst, src)
+}
+func (m *DeliveryStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliveryStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveryStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveryStatus proto.InternalMessageInfo
+
 func (m *EventProtocol) Reset()      { *m = EventProtocol{} }
 func (*EventProtocol) ProtoMessage() {}
 func (*EventProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_c8a632955ef38b96, []int{0}
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{1}
 }
 func (m *EventProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -71,10 +101,38 @@ func (m *EventProtocol) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventProtocol proto.InternalMessageInfo
 
+func (m *EventSourceRestartPolicy) Reset()      { *m = EventSourceRestartPolicy{} }
+func (*EventSourceRestartPolicy) ProtoMessage() {}
+func (*EventSourceRestartPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{2}
+}
+func (m *EventSourceRestartPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSourceRestartPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *EventSourceRestartPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSourceRestartPolicy.Merge(dst, src)
+}
+func (m *EventSourceRestartPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSourceRestartPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSourceRestartPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSourceRestartPolicy proto.InternalMessageInfo
+
 func (m *Gateway) Reset()      { *m = Gateway{} }
 func (*Gateway) ProtoMessage() {}
 func (*Gateway) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_c8a632955ef38b96, []int{1}
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{3}
 }
 func (m *Gateway) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -102,7 +160,7 @@ var xxx_messageInfo_Gateway proto.InternalMessageInfo
 func (m *GatewayList) Reset()      { *m = GatewayList{} }
 func (*GatewayList) ProtoMessage() {}
 func (*GatewayList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_c8a632955ef38b96, []int{2}
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{4}
 }
 func (m *GatewayList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -130,7 +188,7 @@ var xxx_messageInfo_GatewayList proto.InternalMessageInfo
 func (m *GatewayNotificationWatcher) Reset()      { *m = GatewayNotificationWatcher{} }
 func (*GatewayNotificationWatcher) ProtoMessage() {}
 func (*GatewayNotificationWatcher) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_c8a632955ef38b96, []int{3}
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{5}
 }
 func (m *GatewayNotificationWatcher) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,10 +213,66 @@ func (m *GatewayNotificationWatcher) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GatewayNotificationWatcher proto.InternalMessageInfo
 
+func (m *GatewayServer) Reset()      { *m = GatewayServer{} }
+func (*GatewayServer) ProtoMessage() {}
+func (*GatewayServer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{6}
+}
+func (m *GatewayServer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayServer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *GatewayServer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayServer.Merge(dst, src)
+}
+func (m *GatewayServer) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayServer) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayServer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayServer proto.InternalMessageInfo
+
+func (m *GatewayServerTLS) Reset()      { *m = GatewayServerTLS{} }
+func (*GatewayServerTLS) ProtoMessage() {}
+func (*GatewayServerTLS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{7}
+}
+func (m *GatewayServerTLS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayServerTLS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *GatewayServerTLS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayServerTLS.Merge(dst, src)
+}
+func (m *GatewayServerTLS) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayServerTLS) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayServerTLS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayServerTLS proto.InternalMessageInfo
+
 func (m *GatewaySpec) Reset()      { *m = GatewaySpec{} }
 func (*GatewaySpec) ProtoMessage() {}
 func (*GatewaySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_c8a632955ef38b96, []int{4}
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{8}
 }
 func (m *GatewaySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,7 +300,7 @@ var xxx_messageInfo_GatewaySpec proto.InternalMessageInfo
 func (m *GatewayStatus) Reset()      { *m = GatewayStatus{} }
 func (*GatewayStatus) ProtoMessage() {}
 func (*GatewayStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_c8a632955ef38b96, []int{5}
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{9}
 }
 func (m *GatewayStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,7 +328,7 @@ var xxx_messageInfo_GatewayStatus proto.InternalMessageInfo
 func (m *Http) Reset()      { *m = Http{} }
 func (*Http) ProtoMessage() {}
 func (*Http) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_c8a632955ef38b96, []int{6}
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{10}
 }
 func (m *Http) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,7 +356,7 @@ var xxx_messageInfo_Http proto.InternalMessageInfo
 func (m *Nats) Reset()      { *m = Nats{} }
 func (*Nats) ProtoMessage() {}
 func (*Nats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_c8a632955ef38b96, []int{7}
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{11}
 }
 func (m *Nats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,7 +384,7 @@ var xxx_messageInfo_Nats proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_c8a632955ef38b96, []int{8}
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{12}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,7 +412,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NotificationWatchers) Reset()      { *m = NotificationWatchers{} }
 func (*NotificationWatchers) ProtoMessage() {}
 func (*NotificationWatchers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_c8a632955ef38b96, []int{9}
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{13}
 }
 func (m *NotificationWatchers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,10 +437,38 @@ func (m *NotificationWatchers) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NotificationWatchers proto.InternalMessageInfo
 
+func (m *Outbox) Reset()      { *m = Outbox{} }
+func (*Outbox) ProtoMessage() {}
+func (*Outbox) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{14}
+}
+func (m *Outbox) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Outbox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *Outbox) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Outbox.Merge(dst, src)
+}
+func (m *Outbox) XXX_Size() int {
+	return m.Size()
+}
+func (m *Outbox) XXX_DiscardUnknown() {
+	xxx_messageInfo_Outbox.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Outbox proto.InternalMessageInfo
+
 func (m *SensorNotificationWatcher) Reset()      { *m = SensorNotificationWatcher{} }
 func (*SensorNotificationWatcher) ProtoMessage() {}
 func (*SensorNotificationWatcher) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_c8a632955ef38b96, []int{10}
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{15}
 }
 func (m *SensorNotificationWatcher) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -351,11 +493,43 @@ func (m *SensorNotificationWatcher) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SensorNotificationWatcher proto.InternalMessageInfo
 
+func (m *WatcherRouting) Reset()      { *m = WatcherRouting{} }
+func (*WatcherRouting) ProtoMessage() {}
+func (*WatcherRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_15925da4bdd99bd4, []int{16}
+}
+func (m *WatcherRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatcherRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *WatcherRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatcherRouting.Merge(dst, src)
+}
+func (m *WatcherRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatcherRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatcherRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatcherRouting proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterType((*DeliveryStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.DeliveryStatus")
 	proto.RegisterType((*EventProtocol)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.EventProtocol")
+	proto.RegisterType((*EventSourceRestartPolicy)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.EventSourceRestartPolicy")
 	proto.RegisterType((*Gateway)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.Gateway")
 	proto.RegisterType((*GatewayList)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.GatewayList")
 	proto.RegisterType((*GatewayNotificationWatcher)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.GatewayNotificationWatcher")
+	proto.RegisterType((*GatewayServer)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.GatewayServer")
+	proto.RegisterType((*GatewayServerTLS)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.GatewayServerTLS")
 	proto.RegisterType((*GatewaySpec)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.GatewaySpec")
 	proto.RegisterType((*GatewayStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.GatewayStatus")
 	proto.RegisterMapType((map[string]NodeStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.GatewayStatus.NodesEntry")
@@ -363,8 +537,49 @@ func init() {
 	proto.RegisterType((*Nats)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.Nats")
 	proto.RegisterType((*NodeStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.NodeStatus")
 	proto.RegisterType((*NotificationWatchers)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.NotificationWatchers")
+	proto.RegisterType((*Outbox)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.Outbox")
 	proto.RegisterType((*SensorNotificationWatcher)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.SensorNotificationWatcher")
+	proto.RegisterType((*WatcherRouting)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.WatcherRouting")
+}
+func (m *DeliveryStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliveryStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Delivered))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Failed))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastError)))
+	i += copy(dAtA[i:], m.LastError)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.LastFailureTime.Size()))
+	n1, err := m.LastFailureTime.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Filtered))
+	return i, nil
 }
+
 func (m *EventProtocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -387,19 +602,58 @@ func (m *EventProtocol) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Http.Size()))
-	n1, err := m.Http.MarshalTo(dAtA[i:])
+	n2, err := m.Http.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n1
+	i += n2
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Nats.Size()))
-	n2, err := m.Nats.MarshalTo(dAtA[i:])
+	n3, err := m.Nats.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n2
+	i += n3
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CloudEventsVersion)))
+	i += copy(dAtA[i:], m.CloudEventsVersion)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CloudEventsMode)))
+	i += copy(dAtA[i:], m.CloudEventsMode)
+	return i, nil
+}
+
+func (m *EventSourceRestartPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSourceRestartPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRestarts != nil {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxRestarts))
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.InitialDelay)))
+	i += copy(dAtA[i:], m.InitialDelay)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxDelay)))
+	i += copy(dAtA[i:], m.MaxDelay)
 	return i, nil
 }
 
@@ -421,27 +675,27 @@ func (m *Gateway) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ObjectMeta.Size()))
-	n3, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	n4, err := m.ObjectMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n4
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Status.Size()))
-	n4, err := m.Status.MarshalTo(dAtA[i:])
+	n5, err := m.Status.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Spec.Size()))
-	n5, err := m.Spec.MarshalTo(dAtA[i:])
+	n6, err := m.Spec.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n6
 	return i, nil
 }
 
@@ -463,11 +717,11 @@ func (m *GatewayList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n6, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n7, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n7
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -510,6 +764,100 @@ func (m *GatewayNotificationWatcher) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
 	i += copy(dAtA[i:], m.Endpoint)
+	if m.Routing != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Routing.Size()))
+		n8, err := m.Routing.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+
+func (m *GatewayServer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayServer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i += copy(dAtA[i:], m.Address)
+	if m.TLS != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.TLS.Size()))
+		n9, err := m.TLS.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *GatewayServerTLS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayServerTLS) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CACertSecret != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.CACertSecret.Size()))
+		n10, err := m.CACertSecret.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.ClientCertSecret != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ClientCertSecret.Size()))
+		n11, err := m.ClientCertSecret.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.ClientKeySecret != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ClientKeySecret.Size()))
+		n12, err := m.ClientKeySecret.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerName)))
+	i += copy(dAtA[i:], m.ServerName)
 	return i, nil
 }
 
@@ -532,11 +880,11 @@ func (m *GatewaySpec) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.DeploySpec.Size()))
-		n7, err := m.DeploySpec.MarshalTo(dAtA[i:])
+		n13, err := m.DeploySpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n13
 	}
 	dAtA[i] = 0x12
 	i++
@@ -554,21 +902,21 @@ func (m *GatewaySpec) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.ServiceSpec.Size()))
-		n8, err := m.ServiceSpec.MarshalTo(dAtA[i:])
+		n14, err := m.ServiceSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n14
 	}
 	if m.Watchers != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Watchers.Size()))
-		n9, err := m.Watchers.MarshalTo(dAtA[i:])
+		n15, err := m.Watchers.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n15
 	}
 	dAtA[i] = 0x3a
 	i++
@@ -577,11 +925,41 @@ func (m *GatewaySpec) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x42
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.EventProtocol.Size()))
-	n10, err := m.EventProtocol.MarshalTo(dAtA[i:])
+	n16, err := m.EventProtocol.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n16
+	if m.Outbox != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Outbox.Size()))
+		n17, err := m.Outbox.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.RestartPolicy != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.RestartPolicy.Size()))
+		n18, err := m.RestartPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.Server != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Server.Size()))
+		n19, err := m.Server.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
 	return i, nil
 }
 
@@ -607,11 +985,11 @@ func (m *GatewayStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
-	n11, err := m.StartedAt.MarshalTo(dAtA[i:])
+	n20, err := m.StartedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n20
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
@@ -640,11 +1018,11 @@ func (m *GatewayStatus) MarshalTo(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 			i++
 			i = encodeVarintGenerated(dAtA, i, uint64((&v).Size()))
-			n12, err := (&v).MarshalTo(dAtA[i:])
+			n21, err := (&v).MarshalTo(dAtA[i:])
 			if err != nil {
 				return 0, err
 			}
-			i += n12
+			i += n21
 		}
 	}
 	return i, nil
@@ -740,11 +1118,11 @@ func (m *NodeStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x3a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
-	n13, err := m.StartedAt.MarshalTo(dAtA[i:])
+	n22, err := m.StartedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n22
 	dAtA[i] = 0x42
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
@@ -752,11 +1130,28 @@ func (m *NodeStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x4a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdateTime.Size()))
-	n14, err := m.UpdateTime.MarshalTo(dAtA[i:])
+	n23, err := m.UpdateTime.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n23
+	if m.Delivery != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Delivery.Size()))
+		n24, err := m.Delivery.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	dAtA[i] = 0x58
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RestartCount))
+	dAtA[i] = 0x62
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastError)))
+	i += copy(dAtA[i:], m.LastError)
 	return i, nil
 }
 
@@ -802,7 +1197,7 @@ func (m *NotificationWatchers) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SensorNotificationWatcher) Marshal() (dAtA []byte, err error) {
+func (m *Outbox) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -812,18 +1207,101 @@ func (m *SensorNotificationWatcher) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SensorNotificationWatcher) MarshalTo(dAtA []byte) (int, error) {
+func (m *Outbox) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	return i, nil
-}
-
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxSize)))
+	i += copy(dAtA[i:], m.MaxSize)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxAge)))
+	i += copy(dAtA[i:], m.MaxAge)
+	return i, nil
+}
+
+func (m *SensorNotificationWatcher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SensorNotificationWatcher) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if m.Routing != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Routing.Size()))
+		n25, err := m.Routing.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	return i, nil
+}
+
+func (m *WatcherRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatcherRouting) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventSources) > 0 {
+		for _, s := range m.EventSources {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Filter != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Filter.Size()))
+		n26, err := m.Filter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	return i, nil
+}
+
 func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -833,6 +1311,22 @@ func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return offset + 1
 }
+func (m *DeliveryStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Delivered))
+	n += 1 + sovGenerated(uint64(m.Failed))
+	l = len(m.LastError)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastFailureTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Filtered))
+	return n
+}
+
 func (m *EventProtocol) Size() (n int) {
 	if m == nil {
 		return 0
@@ -845,6 +1339,26 @@ func (m *EventProtocol) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Nats.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CloudEventsVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CloudEventsMode)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *EventSourceRestartPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRestarts != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxRestarts))
+	}
+	l = len(m.InitialDelay)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MaxDelay)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -892,6 +1406,48 @@ func (m *GatewayNotificationWatcher) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Endpoint)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Routing != nil {
+		l = m.Routing.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayServer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayServerTLS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CACertSecret != nil {
+		l = m.CACertSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ClientCertSecret != nil {
+		l = m.ClientCertSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ClientKeySecret != nil {
+		l = m.ClientKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ServerName)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -923,6 +1479,18 @@ func (m *GatewaySpec) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.EventProtocol.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Outbox != nil {
+		l = m.Outbox.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RestartPolicy != nil {
+		l = m.RestartPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Server != nil {
+		l = m.Server.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -998,6 +1566,13 @@ func (m *NodeStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.UpdateTime.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Delivery != nil {
+		l = m.Delivery.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.RestartCount))
+	l = len(m.LastError)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -1022,6 +1597,21 @@ func (m *NotificationWatchers) Size() (n int) {
 	return n
 }
 
+func (m *Outbox) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MaxSize)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MaxAge)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SensorNotificationWatcher) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1030,6 +1620,29 @@ func (m *SensorNotificationWatcher) Size() (n int) {
 	_ = l
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Routing != nil {
+		l = m.Routing.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WatcherRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EventSources) > 0 {
+		for _, s := range m.EventSources {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1046,6 +1659,20 @@ func sovGenerated(x uint64) (n int) {
 func sozGenerated(x uint64) (n int) {
 	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *DeliveryStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeliveryStatus{`,
+		`Delivered:` + fmt.Sprintf("%v", this.Delivered) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`LastFailureTime:` + strings.Replace(strings.Replace(this.LastFailureTime.String(), "MicroTime", "v1.MicroTime", 1), `&`, ``, 1) + `,`,
+		`Filtered:` + fmt.Sprintf("%v", this.Filtered) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EventProtocol) String() string {
 	if this == nil {
 		return "nil"
@@ -1054,6 +1681,20 @@ func (this *EventProtocol) String() string {
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`Http:` + strings.Replace(strings.Replace(this.Http.String(), "Http", "Http", 1), `&`, ``, 1) + `,`,
 		`Nats:` + strings.Replace(strings.Replace(this.Nats.String(), "Nats", "Nats", 1), `&`, ``, 1) + `,`,
+		`CloudEventsVersion:` + fmt.Sprintf("%v", this.CloudEventsVersion) + `,`,
+		`CloudEventsMode:` + fmt.Sprintf("%v", this.CloudEventsMode) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventSourceRestartPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventSourceRestartPolicy{`,
+		`MaxRestarts:` + valueToStringGenerated(this.MaxRestarts) + `,`,
+		`InitialDelay:` + fmt.Sprintf("%v", this.InitialDelay) + `,`,
+		`MaxDelay:` + fmt.Sprintf("%v", this.MaxDelay) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1089,6 +1730,31 @@ func (this *GatewayNotificationWatcher) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
 		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Routing:` + strings.Replace(fmt.Sprintf("%v", this.Routing), "WatcherRouting", "WatcherRouting", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayServer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayServer{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "GatewayServerTLS", "GatewayServerTLS", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayServerTLS) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayServerTLS{`,
+		`CACertSecret:` + strings.Replace(fmt.Sprintf("%v", this.CACertSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`ClientCertSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`ClientKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`ServerName:` + fmt.Sprintf("%v", this.ServerName) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1106,6 +1772,9 @@ func (this *GatewaySpec) String() string {
 		`Watchers:` + strings.Replace(fmt.Sprintf("%v", this.Watchers), "NotificationWatchers", "NotificationWatchers", 1) + `,`,
 		`ProcessorPort:` + fmt.Sprintf("%v", this.ProcessorPort) + `,`,
 		`EventProtocol:` + strings.Replace(strings.Replace(this.EventProtocol.String(), "EventProtocol", "EventProtocol", 1), `&`, ``, 1) + `,`,
+		`Outbox:` + strings.Replace(fmt.Sprintf("%v", this.Outbox), "Outbox", "Outbox", 1) + `,`,
+		`RestartPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RestartPolicy), "EventSourceRestartPolicy", "EventSourceRestartPolicy", 1) + `,`,
+		`Server:` + strings.Replace(fmt.Sprintf("%v", this.Server), "GatewayServer", "GatewayServer", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1168,6 +1837,9 @@ func (this *NodeStatus) String() string {
 		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "MicroTime", "v1.MicroTime", 1), `&`, ``, 1) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`UpdateTime:` + strings.Replace(strings.Replace(this.UpdateTime.String(), "MicroTime", "v1.MicroTime", 1), `&`, ``, 1) + `,`,
+		`Delivery:` + strings.Replace(fmt.Sprintf("%v", this.Delivery), "DeliveryStatus", "DeliveryStatus", 1) + `,`,
+		`RestartCount:` + fmt.Sprintf("%v", this.RestartCount) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1183,12 +1855,36 @@ func (this *NotificationWatchers) String() string {
 	}, "")
 	return s
 }
+func (this *Outbox) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Outbox{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`MaxSize:` + fmt.Sprintf("%v", this.MaxSize) + `,`,
+		`MaxAge:` + fmt.Sprintf("%v", this.MaxAge) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SensorNotificationWatcher) String() string {
 	if this == nil {
 		return "nil"
 	}
 	s := strings.Join([]string{`&SensorNotificationWatcher{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Routing:` + strings.Replace(fmt.Sprintf("%v", this.Routing), "WatcherRouting", "WatcherRouting", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatcherRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatcherRouting{`,
+		`EventSources:` + fmt.Sprintf("%v", this.EventSources) + `,`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "EventDependencyFilter", "v1alpha1.EventDependencyFilter", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1201,7 +1897,7 @@ func valueToStringGenerated(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
-func (m *EventProtocol) Unmarshal(dAtA []byte) error {
+func (m *DeliveryStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1224,15 +1920,53 @@ func (m *EventProtocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventProtocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeliveryStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeliveryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delivered", wireType)
+			}
+			m.Delivered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delivered |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1257,11 +1991,11 @@ func (m *EventProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = github_com_argoproj_argo_events_pkg_apis_common.EventProtocolType(dAtA[iNdEx:postIndex])
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Http", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1285,15 +2019,15 @@ func (m *EventProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Http.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastFailureTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nats", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filtered", wireType)
 			}
-			var msglen int
+			m.Filtered = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1303,22 +2037,11 @@ func (m *EventProtocol) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Filtered |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Nats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -1340,7 +2063,7 @@ func (m *EventProtocol) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Gateway) Unmarshal(dAtA []byte) error {
+func (m *EventProtocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1363,17 +2086,17 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Gateway: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventProtocol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gateway: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1383,25 +2106,24 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = github_com_argoproj_argo_events_pkg_apis_common.EventProtocolType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Http", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1425,13 +2147,13 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Http.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1455,65 +2177,15 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Nats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GatewayList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudEventsVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1523,27 +2195,26 @@ func (m *GatewayList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CloudEventsVersion = github_com_argoproj_argo_events_pkg_apis_common.CloudEventsSpecVersion(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudEventsMode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1553,22 +2224,20 @@ func (m *GatewayList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Gateway{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CloudEventsMode = github_com_argoproj_argo_events_pkg_apis_common.CloudEventsMode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1591,7 +2260,7 @@ func (m *GatewayList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GatewayNotificationWatcher) Unmarshal(dAtA []byte) error {
+func (m *EventSourceRestartPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1614,17 +2283,17 @@ func (m *GatewayNotificationWatcher) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayNotificationWatcher: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSourceRestartPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayNotificationWatcher: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSourceRestartPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestarts", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1634,24 +2303,15 @@ func (m *GatewayNotificationWatcher) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.MaxRestarts = &v
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelay", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1676,11 +2336,11 @@ func (m *GatewayNotificationWatcher) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Port = string(dAtA[iNdEx:postIndex])
+			m.InitialDelay = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelay", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1705,7 +2365,7 @@ func (m *GatewayNotificationWatcher) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.MaxDelay = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1728,7 +2388,7 @@ func (m *GatewayNotificationWatcher) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GatewaySpec) Unmarshal(dAtA []byte) error {
+func (m *Gateway) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1751,15 +2411,15 @@ func (m *GatewaySpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewaySpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Gateway: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewaySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Gateway: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeploySpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1783,18 +2443,15 @@ func (m *GatewaySpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeploySpec == nil {
-				m.DeploySpec = &v11.Pod{}
-			}
-			if err := m.DeploySpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1804,26 +2461,27 @@ func (m *GatewaySpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMap = string(dAtA[iNdEx:postIndex])
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1833,53 +2491,75 @@ func (m *GatewaySpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventVersion", wireType)
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1903,16 +2583,13 @@ func (m *GatewaySpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServiceSpec == nil {
-				m.ServiceSpec = &v11.Service{}
-			}
-			if err := m.ServiceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Watchers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1936,69 +2613,8 @@ func (m *GatewaySpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Watchers == nil {
-				m.Watchers = &NotificationWatchers{}
-			}
-			if err := m.Watchers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessorPort", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProcessorPort = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventProtocol", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.EventProtocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Gateway{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2023,7 +2639,7 @@ func (m *GatewaySpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GatewayStatus) Unmarshal(dAtA []byte) error {
+func (m *GatewayNotificationWatcher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2046,15 +2662,15 @@ func (m *GatewayStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayNotificationWatcher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayNotificationWatcher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2079,13 +2695,13 @@ func (m *GatewayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2095,25 +2711,24 @@ func (m *GatewayStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Port = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2138,11 +2753,11 @@ func (m *GatewayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routing", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2166,102 +2781,12 @@ func (m *GatewayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nodes == nil {
-				m.Nodes = make(map[string]NodeStatus)
+			if m.Routing == nil {
+				m.Routing = &WatcherRouting{}
 			}
-			var mapkey string
-			mapvalue := &NodeStatus{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &NodeStatus{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Routing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Nodes[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2284,7 +2809,7 @@ func (m *GatewayStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Http) Unmarshal(dAtA []byte) error {
+func (m *GatewayServer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2307,15 +2832,15 @@ func (m *Http) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Http: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayServer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Http: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayServer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2340,7 +2865,40 @@ func (m *Http) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Port = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &GatewayServerTLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2363,7 +2921,7 @@ func (m *Http) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Nats) Unmarshal(dAtA []byte) error {
+func (m *GatewayServerTLS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2386,17 +2944,17 @@ func (m *Nats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Nats: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayServerTLS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Nats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayServerTLS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CACertSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2406,26 +2964,1280 @@ func (m *Nats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if m.CACertSecret == nil {
+				m.CACertSecret = &v11.SecretKeySelector{}
+			}
+			if err := m.CACertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientCertSecret == nil {
+				m.ClientCertSecret = &v11.SecretKeySelector{}
+			}
+			if err := m.ClientCertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientKeySecret == nil {
+				m.ClientKeySecret = &v11.SecretKeySelector{}
+			}
+			if err := m.ClientKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewaySpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewaySpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewaySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploySpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeploySpec == nil {
+				m.DeploySpec = &v11.Pod{}
+			}
+			if err := m.DeploySpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ServiceSpec == nil {
+				m.ServiceSpec = &v11.Service{}
+			}
+			if err := m.ServiceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Watchers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Watchers == nil {
+				m.Watchers = &NotificationWatchers{}
+			}
+			if err := m.Watchers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessorPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessorPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventProtocol", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EventProtocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outbox", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Outbox == nil {
+				m.Outbox = &Outbox{}
+			}
+			if err := m.Outbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartPolicy == nil {
+				m.RestartPolicy = &EventSourceRestartPolicy{}
+			}
+			if err := m.RestartPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Server == nil {
+				m.Server = &GatewayServer{}
+			}
+			if err := m.Server.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nodes == nil {
+				m.Nodes = make(map[string]NodeStatus)
+			}
+			var mapkey string
+			mapvalue := &NodeStatus{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &NodeStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Nodes[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Http) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Http: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Http: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Nats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Nats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Nats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = github_com_argoproj_argo_events_pkg_apis_common.NatsType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delivery", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2435,26 +4247,30 @@ func (m *Nats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = github_com_argoproj_argo_events_pkg_apis_common.NatsType(dAtA[iNdEx:postIndex])
+			if m.Delivery == nil {
+				m.Delivery = &DeliveryStatus{}
+			}
+			if err := m.Delivery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartCount", wireType)
 			}
-			var stringLen uint64
+			m.RestartCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2464,24 +4280,14 @@ func (m *Nats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.RestartCount |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2506,7 +4312,7 @@ func (m *Nats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2529,7 +4335,7 @@ func (m *Nats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+func (m *NotificationWatchers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2552,17 +4358,17 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: NotificationWatchers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NotificationWatchers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateways", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2572,26 +4378,28 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Gateways = append(m.Gateways, GatewayNotificationWatcher{})
+			if err := m.Gateways[len(m.Gateways)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sensors", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2601,53 +4409,76 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			m.Sensors = append(m.Sensors, SensorNotificationWatcher{})
+			if err := m.Sensors[len(m.Sensors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Outbox) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Outbox: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Outbox: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2672,41 +4503,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2731,13 +4532,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.MaxSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2747,21 +4548,20 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2784,7 +4584,7 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotificationWatchers) Unmarshal(dAtA []byte) error {
+func (m *SensorNotificationWatcher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2807,17 +4607,17 @@ func (m *NotificationWatchers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NotificationWatchers: wiretype end group for non-group")
+			return fmt.Errorf("proto: SensorNotificationWatcher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NotificationWatchers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SensorNotificationWatcher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gateways", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2827,26 +4627,24 @@ func (m *NotificationWatchers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Gateways = append(m.Gateways, GatewayNotificationWatcher{})
-			if err := m.Gateways[len(m.Gateways)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sensors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routing", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2870,8 +4668,10 @@ func (m *NotificationWatchers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sensors = append(m.Sensors, SensorNotificationWatcher{})
-			if err := m.Sensors[len(m.Sensors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Routing == nil {
+				m.Routing = &WatcherRouting{}
+			}
+			if err := m.Routing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2896,7 +4696,7 @@ func (m *NotificationWatchers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SensorNotificationWatcher) Unmarshal(dAtA []byte) error {
+func (m *WatcherRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2919,15 +4719,15 @@ func (m *SensorNotificationWatcher) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SensorNotificationWatcher: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatcherRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SensorNotificationWatcher: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatcherRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSources", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2952,7 +4752,40 @@ func (m *SensorNotificationWatcher) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.EventSources = append(m.EventSources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &v1alpha1.EventDependencyFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3081,85 +4914,129 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1/generated.proto", fileDescriptor_generated_c8a632955ef38b96)
-}
-
-var fileDescriptor_generated_c8a632955ef38b96 = []byte{
-	// 1214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xcf, 0xfa, 0x25, 0xb6, 0xc7, 0x71, 0xdb, 0xff, 0xfc, 0x8b, 0x30, 0x46, 0x38, 0xd5, 0x9e,
-	0x02, 0x6a, 0x77, 0x9b, 0xf0, 0xa2, 0x00, 0x42, 0x55, 0xdd, 0x84, 0x26, 0x52, 0x93, 0x86, 0x71,
-	0x02, 0x12, 0xad, 0x44, 0x27, 0xbb, 0x93, 0xf5, 0x36, 0xde, 0x9d, 0xd1, 0xce, 0xd8, 0xc5, 0xa7,
-	0x22, 0x21, 0xae, 0x88, 0x2f, 0xc0, 0x91, 0x6f, 0xc1, 0x11, 0x89, 0x1c, 0x7b, 0xe0, 0xd0, 0x53,
-	0x44, 0xcc, 0x47, 0xe0, 0xd6, 0x13, 0x9a, 0xd9, 0xd9, 0x17, 0xe7, 0x45, 0x49, 0xea, 0xde, 0x76,
-	0x9e, 0xf9, 0x3d, 0xbf, 0xe7, 0x65, 0x9e, 0xf9, 0xed, 0x80, 0x75, 0xcf, 0x17, 0xbd, 0xc1, 0xae,
-	0xe5, 0xd0, 0xc0, 0xc6, 0x91, 0x47, 0x59, 0x44, 0x9f, 0xaa, 0x8f, 0x5b, 0x64, 0x48, 0x42, 0xc1,
-	0x6d, 0xb6, 0xef, 0xd9, 0x98, 0xf9, 0xdc, 0xf6, 0xb0, 0x20, 0xcf, 0xf0, 0xc8, 0x1e, 0x2e, 0xe2,
-	0x3e, 0xeb, 0xe1, 0x45, 0xdb, 0x23, 0x21, 0x89, 0xb0, 0x20, 0xae, 0xc5, 0x22, 0x2a, 0x28, 0xfc,
-	0x34, 0xa3, 0xb2, 0x12, 0x2a, 0xf5, 0xf1, 0x5d, 0x4c, 0x65, 0xb1, 0x7d, 0xcf, 0x92, 0x54, 0x96,
-	0xa6, 0xb2, 0x12, 0xaa, 0xd6, 0x9d, 0x0b, 0x67, 0xe1, 0xd0, 0x20, 0xa0, 0xe1, 0xf1, 0xd8, 0xad,
-	0x5b, 0x39, 0x02, 0x8f, 0x7a, 0xd4, 0x56, 0xe6, 0xdd, 0xc1, 0x9e, 0x5a, 0xa9, 0x85, 0xfa, 0xd2,
-	0x70, 0x73, 0x7f, 0x99, 0x5b, 0x3e, 0x95, 0x94, 0xb6, 0x43, 0x23, 0x62, 0x0f, 0x4f, 0x94, 0xd3,
-	0xfa, 0x28, 0xc3, 0x04, 0xd8, 0xe9, 0xf9, 0x21, 0x89, 0x46, 0x59, 0x1e, 0x01, 0x11, 0xf8, 0x34,
-	0x2f, 0xfb, 0x2c, 0xaf, 0x68, 0x10, 0x0a, 0x3f, 0x20, 0x27, 0x1c, 0x3e, 0x39, 0xcf, 0x81, 0x3b,
-	0x3d, 0x12, 0xe0, 0x13, 0x7e, 0x1f, 0x9e, 0xe5, 0x37, 0x10, 0x7e, 0xdf, 0xf6, 0x43, 0xc1, 0x45,
-	0x74, 0xdc, 0xc9, 0xfc, 0xbd, 0x00, 0x1a, 0xab, 0xb2, 0xa3, 0x5b, 0x72, 0xe9, 0xd0, 0x3e, 0x24,
-	0xa0, 0x24, 0x46, 0x8c, 0x34, 0x8d, 0x1b, 0xc6, 0x42, 0xad, 0xf3, 0xd5, 0xc1, 0xe1, 0xfc, 0xcc,
-	0xf8, 0x70, 0xbe, 0xb4, 0x3d, 0x62, 0xe4, 0xd5, 0xe1, 0xfc, 0xdd, 0x4b, 0x9e, 0x8b, 0x35, 0x41,
-	0x2e, 0x49, 0x90, 0xa2, 0x87, 0x18, 0x94, 0x7a, 0x42, 0xb0, 0x66, 0xe1, 0x86, 0xb1, 0x50, 0x5f,
-	0xba, 0x63, 0xbd, 0xf6, 0xa8, 0x58, 0x6b, 0x42, 0xb0, 0xce, 0x5c, 0x92, 0xa7, 0x5c, 0x21, 0x45,
-	0x2d, 0x43, 0x84, 0x58, 0xf0, 0x66, 0x71, 0xea, 0x10, 0x9b, 0x58, 0xf0, 0x2c, 0x84, 0x5c, 0x21,
-	0x45, 0x6d, 0xfe, 0x59, 0x00, 0x95, 0xfb, 0x31, 0x1a, 0x3e, 0x01, 0x55, 0x39, 0x03, 0x2e, 0x16,
-	0x58, 0x35, 0xaf, 0xbe, 0x74, 0xdb, 0x8a, 0x8f, 0xc4, 0xca, 0x1f, 0x49, 0x16, 0x46, 0xa2, 0xad,
-	0xe1, 0xa2, 0xf5, 0x70, 0xf7, 0x29, 0x71, 0xc4, 0x06, 0x11, 0xb8, 0x03, 0x75, 0x0c, 0x90, 0xd9,
-	0x50, 0xca, 0x0a, 0x19, 0x98, 0xe5, 0x02, 0x8b, 0x01, 0xd7, 0x5d, 0x5b, 0x9b, 0xa2, 0x24, 0x9d,
-	0x75, 0x57, 0xf1, 0x75, 0xae, 0xe8, 0xb8, 0xb3, 0xf1, 0x1a, 0xe9, 0x38, 0xb0, 0x07, 0x4a, 0x9c,
-	0x11, 0x47, 0xb7, 0xf0, 0xcb, 0x37, 0x10, 0x8f, 0x11, 0x27, 0xeb, 0xa4, 0x5c, 0x21, 0x15, 0xc1,
-	0xfc, 0xcb, 0x00, 0x75, 0x8d, 0x79, 0xe0, 0x73, 0x01, 0x1f, 0x9f, 0xe8, 0xa6, 0x75, 0xb1, 0x6e,
-	0x4a, 0x6f, 0xd5, 0xcb, 0x6b, 0x3a, 0x4a, 0x35, 0xb1, 0xe4, 0x3a, 0xe9, 0x81, 0xb2, 0x2f, 0x48,
-	0x20, 0x1b, 0x59, 0x5c, 0xa8, 0x2f, 0x75, 0xa6, 0x2f, 0xac, 0xd3, 0xd0, 0xe1, 0xca, 0xeb, 0x92,
-	0x18, 0xc5, 0xfc, 0xe6, 0xcf, 0x06, 0x68, 0x69, 0xc4, 0x26, 0x15, 0xfe, 0x9e, 0xef, 0x60, 0xe1,
-	0xd3, 0xf0, 0x1b, 0x2c, 0x9c, 0x1e, 0x89, 0xe0, 0x0d, 0x39, 0xa2, 0x41, 0x72, 0xd9, 0x72, 0x13,
-	0x16, 0x10, 0xa4, 0x76, 0x24, 0x82, 0xd1, 0x48, 0xa8, 0x13, 0xcf, 0x21, 0xb6, 0x68, 0x24, 0x90,
-	0xda, 0x81, 0x37, 0x41, 0x95, 0x84, 0x2e, 0xa3, 0x7e, 0x28, 0xd4, 0x39, 0xd5, 0xb2, 0xca, 0x57,
-	0xb5, 0x1d, 0xa5, 0x08, 0xf3, 0xdf, 0x52, 0xda, 0x67, 0xd9, 0x7d, 0x78, 0x1f, 0x00, 0x97, 0xb0,
-	0x3e, 0x55, 0x2b, 0xdd, 0xe9, 0xb7, 0x73, 0x9d, 0xb6, 0xa4, 0x1a, 0xca, 0xbe, 0x6e, 0x51, 0xb7,
-	0x73, 0x45, 0x8e, 0xe6, 0x4a, 0x0a, 0x47, 0x39, 0x57, 0x68, 0x83, 0x9a, 0x43, 0xc3, 0x3d, 0xdf,
-	0x0b, 0x30, 0xd3, 0xd9, 0xfe, 0x4f, 0xe7, 0x51, 0xbb, 0xa7, 0x36, 0x36, 0x30, 0x43, 0x19, 0x46,
-	0x56, 0xa6, 0x84, 0xa6, 0x38, 0x59, 0x59, 0x4e, 0x23, 0x96, 0xc1, 0x9c, 0x6a, 0xff, 0xd7, 0x24,
-	0xe2, 0x3e, 0x0d, 0x9b, 0x25, 0x85, 0xbc, 0xae, 0x91, 0x73, 0xab, 0xb9, 0x3d, 0x34, 0x81, 0x84,
-	0x9b, 0xa0, 0xce, 0x49, 0x34, 0xf4, 0x1d, 0xa2, 0xca, 0x2a, 0xab, 0xb2, 0xde, 0x3d, 0xad, 0xac,
-	0x6e, 0x0c, 0xeb, 0x5c, 0x1d, 0x1f, 0xce, 0xd7, 0xbb, 0x99, 0x0f, 0xca, 0x13, 0xc0, 0x11, 0xa8,
-	0x3e, 0x8b, 0x8f, 0x8c, 0x37, 0x67, 0x15, 0xd9, 0xc3, 0x69, 0xe4, 0xe4, 0xe4, 0x24, 0xf0, 0xce,
-	0x9c, 0x3c, 0xb0, 0x64, 0x85, 0xd2, 0x70, 0xf0, 0x73, 0xd0, 0x60, 0x11, 0x75, 0x08, 0xe7, 0x34,
-	0x92, 0xa7, 0xde, 0xac, 0xa8, 0x2e, 0xbc, 0xa5, 0xbb, 0xd0, 0xd8, 0xca, 0x6f, 0xa2, 0x49, 0x2c,
-	0xfc, 0xc9, 0x00, 0x0d, 0x92, 0x57, 0xe0, 0x66, 0x75, 0x6a, 0xe5, 0x98, 0x50, 0xf4, 0x2c, 0x8f,
-	0x09, 0x33, 0x9a, 0x8c, 0x6a, 0xfe, 0x51, 0x04, 0x8d, 0x09, 0xc5, 0x81, 0xb7, 0x41, 0x99, 0xf5,
-	0x30, 0x4f, 0x46, 0xbf, 0x95, 0xdc, 0x9e, 0x2d, 0x69, 0x7c, 0x75, 0x38, 0x5f, 0xdb, 0xa4, 0x2e,
-	0x51, 0x0b, 0x14, 0x03, 0xe1, 0x23, 0x50, 0xe3, 0x02, 0x47, 0x82, 0xb8, 0x77, 0x85, 0x16, 0xc0,
-	0x0f, 0x2e, 0x26, 0x09, 0xdb, 0x7e, 0x40, 0xb2, 0x61, 0xec, 0x26, 0x24, 0x28, 0xe3, 0x83, 0xef,
-	0x83, 0x4a, 0x40, 0x38, 0xc7, 0x1e, 0xd1, 0x53, 0x76, 0x55, 0xc3, 0x2b, 0x1b, 0xb1, 0x19, 0x25,
-	0xfb, 0xf0, 0x7b, 0x50, 0x0e, 0xa9, 0x4b, 0x78, 0xb3, 0xac, 0xb4, 0xa3, 0xfb, 0xa6, 0x44, 0xd8,
-	0x92, 0x15, 0xf3, 0xd5, 0x50, 0x44, 0x39, 0x31, 0x51, 0x36, 0x14, 0x07, 0x6c, 0x3d, 0x07, 0x20,
-	0xc3, 0xc0, 0x6b, 0xa0, 0xb8, 0x4f, 0x46, 0x71, 0xff, 0x90, 0xfc, 0x84, 0x8f, 0x40, 0x79, 0x88,
-	0xfb, 0x03, 0xa2, 0xbb, 0xb3, 0x3a, 0xd5, 0x88, 0xba, 0x44, 0xff, 0x0b, 0x62, 0xce, 0xcf, 0x0a,
-	0xcb, 0x86, 0xb9, 0x00, 0xd4, 0xff, 0x35, 0x15, 0x25, 0xe3, 0x2c, 0x51, 0x32, 0xc7, 0x06, 0x50,
-	0xff, 0x49, 0xf8, 0x1e, 0x28, 0x0e, 0xa2, 0xbe, 0x46, 0xd6, 0x35, 0xb2, 0xb8, 0x83, 0x1e, 0x20,
-	0x69, 0x87, 0x8f, 0xb5, 0x08, 0xc4, 0x82, 0xb1, 0x76, 0xec, 0xb5, 0xb1, 0x7c, 0xd9, 0xd7, 0x86,
-	0x0c, 0x99, 0x13, 0x90, 0x9b, 0xa0, 0xea, 0xf4, 0x7d, 0x12, 0x8a, 0x75, 0xf7, 0xb8, 0x34, 0xde,
-	0xd3, 0x76, 0x94, 0x22, 0x94, 0x82, 0xf5, 0x07, 0x5c, 0x90, 0x68, 0xdd, 0xd5, 0x53, 0x90, 0x29,
-	0x58, 0xb2, 0x81, 0x32, 0x8c, 0xf9, 0x5b, 0x31, 0x3e, 0x10, 0x3d, 0xd2, 0x2d, 0x50, 0xf0, 0x5d,
-	0x5d, 0x29, 0xd0, 0x8e, 0x85, 0xf5, 0x15, 0x54, 0xf0, 0xdd, 0x54, 0xe8, 0x8b, 0x67, 0x0a, 0xfd,
-	0xc7, 0xa0, 0xee, 0xfa, 0x9c, 0xf5, 0xf1, 0x48, 0x1a, 0x95, 0x64, 0xd5, 0x3a, 0xff, 0xd7, 0xc0,
-	0xfa, 0x4a, 0xb6, 0x85, 0xf2, 0xb8, 0xec, 0x1e, 0xcd, 0x5e, 0xf4, 0x1e, 0x3d, 0xc9, 0xdf, 0xa3,
-	0x8a, 0x9a, 0x14, 0xfb, 0x62, 0xf7, 0x68, 0xc3, 0x77, 0x22, 0x7a, 0xb9, 0xcb, 0x54, 0x3d, 0xe7,
-	0x32, 0x39, 0x00, 0x0c, 0x98, 0x8b, 0x05, 0x91, 0xb4, 0xcd, 0xda, 0xeb, 0x65, 0x93, 0xbe, 0x9a,
-	0x76, 0x52, 0x2a, 0x94, 0xa3, 0x35, 0x7f, 0x2d, 0x80, 0xeb, 0xa7, 0x69, 0x2e, 0xfc, 0xd1, 0x00,
-	0x55, 0x3d, 0xfa, 0xbc, 0x69, 0xa8, 0xeb, 0xbc, 0x33, 0xfd, 0x75, 0x3e, 0x25, 0x54, 0x36, 0x77,
-	0x1a, 0xc3, 0x51, 0x1a, 0x18, 0x3e, 0x07, 0x15, 0x4e, 0x42, 0x4e, 0xa3, 0xe4, 0x39, 0xb2, 0x3d,
-	0x45, 0x0e, 0x5d, 0xc5, 0x74, 0x5a, 0x0a, 0xe9, 0x21, 0xc4, 0x10, 0x8e, 0x92, 0xa8, 0xe6, 0x17,
-	0xe0, 0x9d, 0x33, 0xdd, 0xce, 0x7f, 0xa2, 0x74, 0xac, 0x83, 0xa3, 0xf6, 0xcc, 0x8b, 0xa3, 0xf6,
-	0xcc, 0xcb, 0xa3, 0xf6, 0xcc, 0x0f, 0xe3, 0xb6, 0x71, 0x30, 0x6e, 0x1b, 0x2f, 0xc6, 0x6d, 0xe3,
-	0xe5, 0xb8, 0x6d, 0xfc, 0x3d, 0x6e, 0x1b, 0xbf, 0xfc, 0xd3, 0x9e, 0xf9, 0xb6, 0x9a, 0xa4, 0xf8,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xb0, 0x23, 0xde, 0x62, 0x0e, 0x00, 0x00,
+	proto.RegisterFile("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1/generated.proto", fileDescriptor_generated_15925da4bdd99bd4)
+}
+
+var fileDescriptor_generated_15925da4bdd99bd4 = []byte{
+	// 1910 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcb, 0x6f, 0x24, 0x47,
+	0x19, 0x77, 0xcf, 0x78, 0x5e, 0x35, 0xf6, 0xda, 0xa9, 0x2c, 0xca, 0x60, 0xc4, 0xcc, 0xaa, 0x25,
+	0x22, 0x83, 0x92, 0x9e, 0xac, 0x09, 0xc8, 0xc0, 0x61, 0xe5, 0xb6, 0xbd, 0x59, 0x2b, 0xf6, 0xae,
+	0xa9, 0xf1, 0x82, 0x94, 0x8d, 0x44, 0x6a, 0xbb, 0xcb, 0x33, 0x9d, 0xed, 0xe9, 0x6a, 0x55, 0xd5,
+	0x38, 0x1e, 0x24, 0x94, 0x48, 0x90, 0x1b, 0x07, 0x0e, 0x5c, 0xb9, 0xf0, 0x0f, 0x70, 0xe2, 0x04,
+	0x47, 0x24, 0xf6, 0x98, 0x03, 0x12, 0x11, 0x87, 0x11, 0x3b, 0xfc, 0x07, 0x1c, 0xf7, 0x84, 0xea,
+	0xd1, 0xaf, 0xb1, 0xad, 0x1d, 0xef, 0x58, 0xdc, 0xba, 0xbe, 0xfa, 0xd5, 0xef, 0xab, 0xaf, 0xfa,
+	0x7b, 0x55, 0x81, 0x83, 0x7e, 0x20, 0x06, 0xa3, 0xa7, 0x8e, 0x47, 0x87, 0x5d, 0xcc, 0xfa, 0x34,
+	0x66, 0xf4, 0x53, 0xf5, 0xf1, 0x2e, 0x39, 0x23, 0x91, 0xe0, 0xdd, 0xf8, 0x59, 0xbf, 0x8b, 0xe3,
+	0x80, 0x77, 0xfb, 0x58, 0x90, 0xcf, 0xf0, 0xb8, 0x7b, 0x76, 0x17, 0x87, 0xf1, 0x00, 0xdf, 0xed,
+	0xf6, 0x49, 0x44, 0x18, 0x16, 0xc4, 0x77, 0x62, 0x46, 0x05, 0x85, 0x3f, 0xca, 0xa8, 0x9c, 0x84,
+	0x4a, 0x7d, 0xfc, 0x42, 0x53, 0x39, 0xf1, 0xb3, 0xbe, 0x23, 0xa9, 0x1c, 0x43, 0xe5, 0x24, 0x54,
+	0x1b, 0xf7, 0xe6, 0xde, 0x85, 0x47, 0x87, 0x43, 0x1a, 0xcd, 0xea, 0xde, 0x78, 0x30, 0x37, 0x01,
+	0x27, 0x11, 0xa7, 0xec, 0x4a, 0x2b, 0x36, 0xde, 0xcd, 0x31, 0xf5, 0x69, 0x9f, 0x76, 0x95, 0xf8,
+	0xe9, 0xe8, 0x54, 0x8d, 0xd4, 0x40, 0x7d, 0x19, 0xb8, 0xfd, 0x6c, 0x9b, 0x3b, 0x01, 0x95, 0xdc,
+	0x5d, 0x8f, 0x32, 0xd2, 0x3d, 0xbb, 0x48, 0xf9, 0x7e, 0x86, 0x19, 0x62, 0x6f, 0x10, 0x44, 0x84,
+	0x8d, 0xb3, 0x0d, 0x0d, 0x89, 0xc0, 0x97, 0xad, 0xea, 0x5e, 0xb5, 0x8a, 0x8d, 0x22, 0x11, 0x0c,
+	0xc9, 0x85, 0x05, 0x3f, 0x7c, 0xd5, 0x02, 0xee, 0x0d, 0xc8, 0x10, 0x5f, 0x58, 0xf7, 0xfd, 0xab,
+	0xd6, 0x8d, 0x44, 0x10, 0x76, 0x83, 0x48, 0x70, 0xc1, 0x66, 0x17, 0xd9, 0x7f, 0x2d, 0x81, 0x5b,
+	0x7b, 0x24, 0x0c, 0xce, 0x08, 0x1b, 0xf7, 0x04, 0x16, 0x23, 0x0e, 0xbb, 0xa0, 0xe1, 0x6b, 0x09,
+	0xf1, 0x5b, 0xd6, 0x1d, 0x6b, 0xb3, 0xec, 0xbe, 0xf1, 0x7c, 0xd2, 0x59, 0x9a, 0x4e, 0x3a, 0x8d,
+	0xbd, 0x64, 0x02, 0x65, 0x18, 0xf8, 0x36, 0xa8, 0x9e, 0xe2, 0x20, 0x24, 0x7e, 0xab, 0xa4, 0xd0,
+	0xb7, 0x0c, 0xba, 0x7a, 0x5f, 0x49, 0x91, 0x99, 0x95, 0xc4, 0x21, 0xe6, 0x62, 0x9f, 0x31, 0xca,
+	0x5a, 0xe5, 0x3b, 0xd6, 0x66, 0x23, 0x23, 0x3e, 0x4c, 0x26, 0x50, 0x86, 0x81, 0x0c, 0xac, 0xc9,
+	0x81, 0xa4, 0x19, 0x31, 0x72, 0x12, 0x0c, 0x49, 0x6b, 0xf9, 0x8e, 0xb5, 0xd9, 0xdc, 0xea, 0x3a,
+	0xda, 0x56, 0x27, 0x6f, 0x6b, 0xe6, 0x97, 0xf2, 0x57, 0x38, 0x67, 0x77, 0x9d, 0xa3, 0xc0, 0x63,
+	0x54, 0x2e, 0x73, 0xdf, 0x32, 0x7a, 0xd6, 0x0e, 0x8b, 0x7c, 0x68, 0x56, 0x01, 0x7c, 0x07, 0xd4,
+	0x4f, 0x83, 0x50, 0x28, 0xe3, 0x2b, 0xca, 0x9c, 0x75, 0xb3, 0xb6, 0x7e, 0xdf, 0xc8, 0x51, 0x8a,
+	0xb0, 0xff, 0xb5, 0x0c, 0x56, 0xf7, 0xa5, 0x67, 0x1e, 0xcb, 0xd3, 0xf4, 0x68, 0x08, 0x09, 0x58,
+	0x16, 0xe3, 0x98, 0xa8, 0x83, 0x6b, 0xb8, 0x3f, 0x35, 0x6b, 0x97, 0x4f, 0xc6, 0x31, 0x79, 0x39,
+	0xe9, 0xec, 0x5c, 0x33, 0x40, 0x9c, 0x02, 0xb9, 0x24, 0x41, 0x8a, 0x1e, 0x62, 0xb0, 0x3c, 0x10,
+	0x22, 0x56, 0x27, 0xde, 0xdc, 0xba, 0xe7, 0xbc, 0x76, 0xcc, 0x3a, 0x0f, 0x84, 0x88, 0xdd, 0x95,
+	0x64, 0x9f, 0x72, 0x84, 0x14, 0xb5, 0x54, 0x11, 0x61, 0xc1, 0xd5, 0x9f, 0x5a, 0x4c, 0xc5, 0x43,
+	0x2c, 0x78, 0xa6, 0x42, 0x8e, 0x90, 0xa2, 0x86, 0xbf, 0xb7, 0x00, 0xf4, 0x42, 0x3a, 0xf2, 0x95,
+	0x99, 0xfc, 0x67, 0x84, 0xf1, 0x80, 0x46, 0xea, 0x27, 0x37, 0x5c, 0xdf, 0x2c, 0x80, 0xbb, 0x17,
+	0x10, 0x2f, 0x27, 0x9d, 0xfb, 0xd7, 0x3d, 0xc9, 0x1c, 0x4b, 0x2f, 0x26, 0x9e, 0x61, 0x42, 0x97,
+	0xe8, 0x87, 0xbf, 0xb1, 0xc0, 0x5a, 0x4e, 0x7c, 0x44, 0x7d, 0xa2, 0x7c, 0xa1, 0xe1, 0x7e, 0x94,
+	0xf8, 0xd1, 0x6e, 0x71, 0xfa, 0xe5, 0xa4, 0x73, 0x6f, 0x81, 0x0d, 0x49, 0x0a, 0x34, 0xab, 0xd2,
+	0xfe, 0xb3, 0x05, 0x5a, 0x6a, 0xd8, 0xa3, 0x23, 0xe6, 0x11, 0x44, 0xb8, 0xc0, 0x4c, 0x1c, 0xd3,
+	0x30, 0xf0, 0xc6, 0xf0, 0x2e, 0x68, 0x0e, 0xf1, 0xb9, 0x91, 0x71, 0xe5, 0x6e, 0x15, 0x77, 0x6d,
+	0x3a, 0xe9, 0x34, 0x8f, 0x32, 0x31, 0xca, 0x63, 0xe0, 0x36, 0x58, 0x09, 0xa2, 0x40, 0x04, 0x38,
+	0xdc, 0x23, 0x21, 0x1e, 0x2b, 0xdf, 0x69, 0xb8, 0xb7, 0x8d, 0x49, 0x2b, 0x07, 0xb9, 0x39, 0x54,
+	0x40, 0xca, 0xa0, 0x18, 0xe2, 0x73, 0xbd, 0x4a, 0x07, 0x6e, 0x1a, 0x14, 0x47, 0x46, 0x8e, 0x52,
+	0x84, 0xfd, 0xf7, 0x12, 0xa8, 0x7d, 0xa0, 0x7d, 0x00, 0x7e, 0x02, 0xea, 0x32, 0x1a, 0x7d, 0x2c,
+	0xb0, 0xda, 0x63, 0x73, 0xeb, 0xbd, 0xf9, 0x62, 0xf7, 0xd1, 0xd3, 0x4f, 0x89, 0x27, 0x8e, 0x88,
+	0xc0, 0x2e, 0x34, 0xba, 0x40, 0x26, 0x43, 0x29, 0x2b, 0x8c, 0x41, 0x95, 0xab, 0xc4, 0x65, 0x62,
+	0xe1, 0xc1, 0x02, 0x8e, 0x6a, 0x76, 0xad, 0x13, 0x61, 0x96, 0xc7, 0xf4, 0x18, 0x19, 0x3d, 0x70,
+	0x00, 0x96, 0x79, 0x4c, 0x3c, 0x13, 0x18, 0xf7, 0x6f, 0x40, 0x5f, 0x4c, 0xbc, 0x2c, 0x3e, 0xe4,
+	0x08, 0x29, 0x0d, 0xf6, 0x3f, 0x2c, 0xd0, 0x34, 0x98, 0xc3, 0x80, 0x0b, 0xf8, 0xf1, 0x85, 0xd3,
+	0x74, 0xe6, 0x3b, 0x4d, 0xb9, 0x5a, 0x9d, 0x65, 0xfa, 0xdf, 0x12, 0x49, 0xee, 0x24, 0xfb, 0xa0,
+	0x12, 0x08, 0x32, 0x94, 0x07, 0x59, 0xde, 0x6c, 0x6e, 0xb9, 0x8b, 0x1b, 0xe6, 0xae, 0x1a, 0x75,
+	0x95, 0x03, 0x49, 0x8c, 0x34, 0xbf, 0xfd, 0x45, 0x09, 0x6c, 0x18, 0xc4, 0x43, 0x2a, 0x82, 0xd3,
+	0xc0, 0xc3, 0x22, 0xa0, 0xd1, 0xcf, 0xb1, 0xf0, 0x06, 0x84, 0xc1, 0x3b, 0x32, 0xf1, 0x0c, 0x93,
+	0x14, 0x9a, 0xcb, 0x1b, 0x43, 0x82, 0xd4, 0x8c, 0x44, 0xc4, 0x94, 0x09, 0xe3, 0xc1, 0x29, 0xe2,
+	0x98, 0x32, 0x81, 0xd4, 0x8c, 0xf4, 0x58, 0x12, 0xf9, 0x31, 0x0d, 0x22, 0x31, 0xeb, 0xb1, 0xfb,
+	0x46, 0x8e, 0x52, 0x04, 0x8c, 0x41, 0x8d, 0xd1, 0x91, 0x08, 0xa2, 0xbe, 0x29, 0x30, 0x07, 0x0b,
+	0xd8, 0x6e, 0xcc, 0x40, 0x9a, 0xd0, 0x6d, 0x4e, 0x27, 0x9d, 0x9a, 0x19, 0xa0, 0x44, 0x8d, 0xfd,
+	0x47, 0x0b, 0xac, 0x26, 0x7f, 0x9f, 0xb0, 0x33, 0xc2, 0xe0, 0x77, 0x41, 0x0d, 0xfb, 0x3e, 0x23,
+	0x9c, 0x1b, 0xc3, 0xd7, 0xcc, 0x86, 0x6b, 0x3b, 0x5a, 0x8c, 0x92, 0x79, 0x78, 0x0a, 0xca, 0x22,
+	0x4c, 0xfc, 0xfd, 0xc3, 0x1b, 0xf0, 0x3f, 0xb5, 0x83, 0x93, 0xc3, 0x9e, 0x5b, 0x9b, 0x4e, 0x3a,
+	0xe5, 0x93, 0xc3, 0x1e, 0x92, 0x0a, 0xec, 0xff, 0x96, 0xc0, 0xfa, 0x2c, 0x04, 0x3e, 0x01, 0x2b,
+	0x1e, 0xde, 0x25, 0x4c, 0xf4, 0x88, 0xc7, 0x88, 0x30, 0x7e, 0xf8, 0x9d, 0x9c, 0x1f, 0x3a, 0xb2,
+	0x81, 0x92, 0x5e, 0xa7, 0x11, 0x1f, 0x92, 0x71, 0x8f, 0x84, 0xc4, 0x13, 0x94, 0xb9, 0xeb, 0x32,
+	0xd1, 0xec, 0xee, 0x64, 0xcb, 0x51, 0x81, 0x0c, 0xf6, 0xc1, 0xba, 0x17, 0x06, 0x24, 0x12, 0x39,
+	0x05, 0xa5, 0xeb, 0x28, 0xb8, 0x3d, 0x9d, 0x74, 0xd6, 0x77, 0x67, 0x28, 0xd0, 0x05, 0x52, 0xe8,
+	0xcb, 0x0c, 0x2f, 0x65, 0x6a, 0xb1, 0xd2, 0x53, 0xbe, 0x8e, 0x9e, 0x37, 0x75, 0x11, 0x28, 0x30,
+	0xa0, 0x59, 0x4a, 0xb8, 0x05, 0x00, 0x57, 0x07, 0x27, 0x7d, 0xd7, 0x94, 0xb5, 0x34, 0x9b, 0xf5,
+	0xd2, 0x19, 0x94, 0x43, 0xd9, 0xff, 0xac, 0xa5, 0x31, 0x2f, 0x33, 0x01, 0xfc, 0x00, 0x00, 0x9f,
+	0xc4, 0x21, 0x55, 0x23, 0x73, 0xda, 0x6f, 0x5d, 0xb6, 0xc9, 0x63, 0xea, 0xbb, 0xb7, 0x24, 0xf1,
+	0x5e, 0x0a, 0x47, 0xb9, 0xa5, 0xb2, 0xfd, 0xf2, 0x68, 0x74, 0x1a, 0xf4, 0x87, 0x38, 0x36, 0x91,
+	0x93, 0xb6, 0x5f, 0xbb, 0x6a, 0xe2, 0x08, 0xc7, 0x28, 0xc3, 0xc8, 0x28, 0x53, 0xad, 0x4c, 0xb9,
+	0x18, 0x65, 0xb9, 0x2e, 0x64, 0x1b, 0xac, 0x28, 0x1f, 0x2b, 0x16, 0xee, 0xb4, 0xa2, 0xec, 0xe7,
+	0xe6, 0x50, 0x01, 0x09, 0x1f, 0x82, 0xa6, 0xb4, 0x39, 0xf0, 0x88, 0x32, 0xab, 0xa2, 0xcc, 0xfa,
+	0xd6, 0xe5, 0x67, 0xaf, 0x60, 0xba, 0xb6, 0xf5, 0xb2, 0x35, 0x28, 0x4f, 0x00, 0xc7, 0xa0, 0xfe,
+	0x99, 0x8e, 0x3b, 0xde, 0xaa, 0x2a, 0xb2, 0x47, 0x8b, 0x34, 0x2c, 0x17, 0xb3, 0x12, 0x77, 0x57,
+	0x64, 0xf2, 0x48, 0x46, 0x28, 0x55, 0x07, 0x7f, 0x02, 0x56, 0x63, 0x46, 0x3d, 0xc2, 0x39, 0x65,
+	0x32, 0x03, 0xb5, 0x6a, 0xea, 0x14, 0xbe, 0x61, 0x4e, 0x61, 0xf5, 0x38, 0x3f, 0x89, 0x8a, 0x58,
+	0xf8, 0xa5, 0x05, 0x56, 0x49, 0xbe, 0xc7, 0x6b, 0xd5, 0x17, 0xae, 0x62, 0x85, 0x9e, 0x31, 0xdb,
+	0x47, 0x41, 0x8c, 0x8a, 0x5a, 0x21, 0x01, 0x55, 0x3a, 0x12, 0x4f, 0xe9, 0x79, 0xab, 0xa1, 0xf4,
+	0xef, 0x2c, 0xa0, 0xff, 0x91, 0x22, 0x72, 0x81, 0x2c, 0x9d, 0xfa, 0x1b, 0x19, 0x72, 0xf8, 0x5b,
+	0x0b, 0xac, 0xb2, 0x7c, 0x1f, 0xd3, 0x02, 0x4a, 0x5d, 0x6f, 0x51, 0x73, 0x2f, 0x69, 0x91, 0xdc,
+	0x37, 0xa4, 0xd5, 0x05, 0x11, 0x2a, 0x2a, 0x87, 0x21, 0xa8, 0xea, 0xc8, 0x6b, 0x35, 0x6f, 0xac,
+	0x77, 0x50, 0x7c, 0xda, 0x78, 0xfd, 0x8d, 0x8c, 0x0e, 0xfb, 0x6f, 0xe5, 0x2c, 0xe7, 0xeb, 0x4e,
+	0xe2, 0x3d, 0x50, 0x89, 0x07, 0x98, 0x27, 0xa5, 0x6e, 0x23, 0xa9, 0x96, 0xc7, 0x52, 0xf8, 0x72,
+	0xd2, 0x69, 0x3c, 0xa4, 0x3e, 0x51, 0x03, 0xa4, 0x81, 0xf0, 0x09, 0x68, 0x28, 0x03, 0x88, 0xbf,
+	0x93, 0x64, 0xc6, 0xef, 0xcd, 0xd7, 0x02, 0xa8, 0x7b, 0x50, 0x1a, 0xf0, 0xbd, 0x84, 0x04, 0x65,
+	0x7c, 0xb2, 0x04, 0x0d, 0x09, 0xe7, 0xb8, 0x9f, 0xe4, 0xaa, 0xb4, 0x04, 0x1d, 0x69, 0x31, 0x4a,
+	0xe6, 0xe1, 0x39, 0xa8, 0x44, 0xd4, 0x27, 0xbc, 0x55, 0x51, 0xbd, 0x42, 0xef, 0xa6, 0x9a, 0x2e,
+	0x47, 0x5a, 0xcc, 0xf7, 0x23, 0xc1, 0x72, 0xcd, 0x83, 0x92, 0x21, 0xad, 0x70, 0xe3, 0x73, 0x00,
+	0x32, 0x0c, 0x5c, 0x07, 0xe5, 0x67, 0x64, 0xac, 0xcf, 0x0f, 0xc9, 0x4f, 0xf8, 0x04, 0x54, 0xce,
+	0x70, 0x38, 0x22, 0xe6, 0x74, 0xf6, 0x17, 0x4a, 0x03, 0x3e, 0x31, 0xbd, 0x9f, 0xe6, 0xfc, 0x71,
+	0x69, 0xdb, 0xb2, 0x37, 0x81, 0xba, 0x25, 0xa5, 0x4d, 0x88, 0x75, 0x55, 0x13, 0x62, 0x4f, 0x2d,
+	0xa0, 0x6e, 0x3b, 0xf0, 0xdb, 0xa0, 0x3c, 0x62, 0xa1, 0x41, 0x36, 0x0d, 0xb2, 0xfc, 0x18, 0x1d,
+	0x22, 0x29, 0x87, 0x1f, 0x9b, 0x44, 0xab, 0x93, 0xf2, 0x83, 0x99, 0x3b, 0xe3, 0xf6, 0x75, 0x2f,
+	0x16, 0x52, 0x65, 0x2e, 0x49, 0xbf, 0x03, 0xea, 0xba, 0x2e, 0x1d, 0xf8, 0xb3, 0xad, 0xd0, 0xae,
+	0x91, 0xa3, 0x14, 0xa1, 0xaa, 0x44, 0x38, 0xe2, 0x82, 0xb0, 0x03, 0xdf, 0x78, 0x41, 0x56, 0x25,
+	0x92, 0x09, 0x94, 0x61, 0xec, 0x2f, 0x2b, 0xfa, 0x87, 0x18, 0x97, 0xde, 0x00, 0xa5, 0xc0, 0x37,
+	0x96, 0x02, 0xb3, 0xb0, 0x74, 0xb0, 0x87, 0x4a, 0x81, 0x9f, 0x36, 0x76, 0xe5, 0x2b, 0x1b, 0xbb,
+	0x1f, 0x80, 0xa6, 0x1f, 0xf0, 0x38, 0xc4, 0x63, 0x55, 0x31, 0xf5, 0xa5, 0xeb, 0x4d, 0x03, 0x6c,
+	0xee, 0x65, 0x53, 0x28, 0x8f, 0xcb, 0xe2, 0xa8, 0x3a, 0x6f, 0x1c, 0x7d, 0x92, 0x8f, 0xa3, 0xda,
+	0xeb, 0x3d, 0x2a, 0xcc, 0x1d, 0x4c, 0xf5, 0x57, 0x04, 0x93, 0x07, 0xc0, 0x28, 0xf6, 0xb1, 0xd0,
+	0x4f, 0x1c, 0x8d, 0xd7, 0xdb, 0x4d, 0xda, 0x57, 0x3c, 0x4e, 0xa9, 0x50, 0x8e, 0x16, 0x72, 0x50,
+	0x37, 0x4f, 0x36, 0x49, 0xd2, 0x5d, 0xa4, 0xc9, 0x2d, 0xbe, 0x19, 0xe9, 0xda, 0x98, 0xc8, 0x50,
+	0xaa, 0x48, 0x36, 0x08, 0x26, 0xe3, 0xee, 0xd2, 0x51, 0x24, 0x54, 0x9a, 0xad, 0x64, 0x0d, 0x02,
+	0xca, 0xcd, 0xa1, 0x02, 0xb2, 0xf8, 0x58, 0xb4, 0xf2, 0xea, 0xc7, 0x22, 0xfb, 0x0f, 0x25, 0x70,
+	0xfb, 0xb2, 0xba, 0x0d, 0x7f, 0x6d, 0x81, 0xba, 0xd9, 0xbf, 0x6c, 0xad, 0x65, 0xba, 0x7a, 0xbc,
+	0x78, 0xba, 0xba, 0x44, 0x55, 0x16, 0x57, 0x06, 0xc3, 0x51, 0xaa, 0x18, 0x7e, 0x0e, 0x6a, 0xfa,
+	0xc9, 0x32, 0xb9, 0x5e, 0x9d, 0x2c, 0xb0, 0x87, 0x9e, 0x62, 0xba, 0x6c, 0x0b, 0xa9, 0x93, 0x69,
+	0x08, 0x47, 0x89, 0x56, 0xfb, 0x57, 0xc0, 0x14, 0x63, 0x95, 0xb8, 0xb0, 0x18, 0x5c, 0x48, 0x5c,
+	0x58, 0x0c, 0x90, 0x9a, 0x51, 0xbe, 0x8b, 0xcf, 0x7b, 0xc1, 0x2f, 0x93, 0x9c, 0x94, 0xf9, 0xae,
+	0x16, 0xa3, 0x64, 0x1e, 0xbe, 0x0d, 0xaa, 0x43, 0x7c, 0xbe, 0xd3, 0x4f, 0xa2, 0x3a, 0xbd, 0x34,
+	0x1f, 0x29, 0x29, 0x32, 0xb3, 0xf6, 0x9f, 0x2c, 0xf0, 0xcd, 0x2b, 0xb7, 0x3d, 0xc7, 0x95, 0x2f,
+	0x77, 0x45, 0x2b, 0xfd, 0x7f, 0xae, 0x68, 0x7f, 0xb1, 0xc0, 0xad, 0x22, 0x10, 0xbe, 0x6f, 0xfa,
+	0x5d, 0xdd, 0x6d, 0x68, 0x6f, 0x6a, 0xe8, 0x4b, 0x4d, 0xae, 0x0b, 0xe1, 0xa8, 0x80, 0x82, 0x1c,
+	0x54, 0xf5, 0x83, 0xa1, 0xd9, 0xf9, 0x35, 0x3a, 0x53, 0xfd, 0xf3, 0x66, 0x7a, 0x9d, 0x3d, 0x12,
+	0x93, 0xc8, 0x27, 0x91, 0x37, 0xd6, 0x2f, 0x92, 0xba, 0xd9, 0xd0, 0xdf, 0xc8, 0xa8, 0x72, 0x9d,
+	0xe7, 0x2f, 0xda, 0x4b, 0x5f, 0xbd, 0x68, 0x2f, 0x7d, 0xfd, 0xa2, 0xbd, 0xf4, 0xc5, 0xb4, 0x6d,
+	0x3d, 0x9f, 0xb6, 0xad, 0xaf, 0xa6, 0x6d, 0xeb, 0xeb, 0x69, 0xdb, 0xfa, 0xf7, 0xb4, 0x6d, 0xfd,
+	0xee, 0x3f, 0xed, 0xa5, 0x8f, 0xea, 0x09, 0xf3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x6a,
+	0xfc, 0x08, 0x41, 0x18, 0x00, 0x00,
 }
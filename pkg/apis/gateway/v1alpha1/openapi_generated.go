@@ -28,17 +28,73 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.DeliveryStatus":             schema_pkg_apis_gateway_v1alpha1_DeliveryStatus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.EventProtocol":              schema_pkg_apis_gateway_v1alpha1_EventProtocol(ref),
+		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.EventSourceRestartPolicy":   schema_pkg_apis_gateway_v1alpha1_EventSourceRestartPolicy(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.Gateway":                    schema_pkg_apis_gateway_v1alpha1_Gateway(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewayList":                schema_pkg_apis_gateway_v1alpha1_GatewayList(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewayNotificationWatcher": schema_pkg_apis_gateway_v1alpha1_GatewayNotificationWatcher(ref),
+		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewayServer":              schema_pkg_apis_gateway_v1alpha1_GatewayServer(ref),
+		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewayServerTLS":           schema_pkg_apis_gateway_v1alpha1_GatewayServerTLS(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewaySpec":                schema_pkg_apis_gateway_v1alpha1_GatewaySpec(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewayStatus":              schema_pkg_apis_gateway_v1alpha1_GatewayStatus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.Http":                       schema_pkg_apis_gateway_v1alpha1_Http(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.Nats":                       schema_pkg_apis_gateway_v1alpha1_Nats(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.NodeStatus":                 schema_pkg_apis_gateway_v1alpha1_NodeStatus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.NotificationWatchers":       schema_pkg_apis_gateway_v1alpha1_NotificationWatchers(ref),
+		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.Outbox":                     schema_pkg_apis_gateway_v1alpha1_Outbox(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.SensorNotificationWatcher":  schema_pkg_apis_gateway_v1alpha1_SensorNotificationWatcher(ref),
+		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.WatcherRouting":             schema_pkg_apis_gateway_v1alpha1_WatcherRouting(ref),
+	}
+}
+
+func schema_pkg_apis_gateway_v1alpha1_DeliveryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeliveryStatus is the outcome of the dispatch of the events of an event source to the watchers",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"delivered": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delivered is the number of events dispatched to all the watchers",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed is the number of events that failed to be dispatched to at least one watcher",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError is the error of the latest failed dispatch",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastFailureTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastFailureTime is the time of the latest failed dispatch",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime"),
+						},
+					},
+					"filtered": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filtered is the number of events dropped by the filters of the event source instead of being dispatched",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"delivered", "failed"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime"},
 	}
 }
 
@@ -65,6 +121,20 @@ func schema_pkg_apis_gateway_v1alpha1_EventProtocol(ref common.ReferenceCallback
 							Ref: ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.Nats"),
 						},
 					},
+					"cloudEventsVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudEventsVersion is the version of the CloudEvents specification the events are dispatched with, 0.1 or 1.0. Defaults to 0.1, the JSON envelope sensors have always accepted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cloudEventsMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudEventsMode is the HTTP content mode of CloudEvents 1.0 events, binary or structured. Defaults to binary. Events are always structured over NATS.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"type", "http", "nats"},
 			},
@@ -74,6 +144,41 @@ func schema_pkg_apis_gateway_v1alpha1_EventProtocol(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_gateway_v1alpha1_EventSourceRestartPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventSourceRestartPolicy describes how the event sources that failed are restarted. Failed event sources are restarted with an exponential backoff, until they fail more than MaxRestarts times in a row.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxRestarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRestarts is the number of consecutive restarts after which a failed event source is not restarted anymore. Zero disables restarts. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"initialDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialDelay is the delay before the first restart, e.g. 1s. The delay doubles on each consecutive restart. Defaults to 1s.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDelay is the maximum delay between restarts, e.g. 5m. An event source running for longer than the max delay before failing is considered healthy again, and its consecutive restarts are reset. Defaults to 5m.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_gateway_v1alpha1_Gateway(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -194,11 +299,87 @@ func schema_pkg_apis_gateway_v1alpha1_GatewayNotificationWatcher(ref common.Refe
 							Format:      "",
 						},
 					},
+					"routing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routing selects the events sent to the gateway. The gateway receives all the events if not set.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.WatcherRouting"),
+						},
+					},
 				},
 				Required: []string{"name", "port", "endpoint"},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.WatcherRouting"},
+	}
+}
+
+func schema_pkg_apis_gateway_v1alpha1_GatewayServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GatewayServer is a gateway server reachable over the network, e.g. a gateway server shared by several gateways behind a service.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Address of the gateway server, e.g. calendar-gateway-server.event-sources.svc:9330",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS secures the connection to the gateway server. The connection is insecure without TLS.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewayServerTLS"),
+						},
+					},
+				},
+				Required: []string{"address"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewayServerTLS"},
+	}
+}
+
+func schema_pkg_apis_gateway_v1alpha1_GatewayServerTLS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GatewayServerTLS holds the certificates of the TLS connection to a gateway server. The secrets are read from the namespace of the gateway.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"caCertSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CACertSecret refers to the CA certificate the certificate of the gateway server is verified with. Defaults to the CA certificates of the host.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"clientCertSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientCertSecret refers to the certificate the gateway client presents to the gateway server for mutual TLS.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"clientKeySecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientKeySecret refers to the key of the client certificate.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"serverName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerName is the name the certificate of the gateway server is verified against. Defaults to the host of the address.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -261,12 +442,30 @@ func schema_pkg_apis_gateway_v1alpha1_GatewaySpec(ref common.ReferenceCallback)
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.EventProtocol"),
 						},
 					},
+					"outbox": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Outbox persists the events of the gateway until they are dispatched to the watchers",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.Outbox"),
+						},
+					},
+					"restartPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestartPolicy is the policy of the restarts of the event sources that failed",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.EventSourceRestartPolicy"),
+						},
+					},
+					"server": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Server is the gateway server running the event sources, when it runs outside of the gateway pod. Defaults to the gateway server container of the gateway pod, listening on the processor port.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewayServer"),
+						},
+					},
 				},
 				Required: []string{"deploySpec", "type", "eventVersion", "processorPort", "eventProtocol"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.EventProtocol", "github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.NotificationWatchers", "k8s.io/api/core/v1.Pod", "k8s.io/api/core/v1.Service"},
+			"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.EventProtocol", "github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.EventSourceRestartPolicy", "github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewayServer", "github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.NotificationWatchers", "github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.Outbox", "k8s.io/api/core/v1.Pod", "k8s.io/api/core/v1.Service"},
 	}
 }
 
@@ -425,12 +624,32 @@ func schema_pkg_apis_gateway_v1alpha1_NodeStatus(ref common.ReferenceCallback) c
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime"),
 						},
 					},
+					"delivery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delivery is the outcome of the dispatch of the events of the node to the watchers",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.DeliveryStatus"),
+						},
+					},
+					"restartCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestartCount is the number of times the event source of the node was restarted after failing",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError is the error of the latest failure of the event source of the node",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"id", "name", "displayName", "phase"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime"},
+			"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.DeliveryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime"},
 	}
 }
 
@@ -475,6 +694,42 @@ func schema_pkg_apis_gateway_v1alpha1_NotificationWatchers(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_gateway_v1alpha1_Outbox(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Outbox persists the events produced by the event sources on a volume of the gateway pod before they are dispatched. An event is removed from the outbox once it is dispatched to all the watchers. The events left in the outbox are dispatched again when the gateway restarts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the directory the events are persisted in. It should be on a persistent volume mounted in the gateway client container.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSize is the maximum size of the outbox, e.g. 100Mi. The oldest events are dropped once it is reached. Defaults to 100Mi.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAge is the duration after which events not yet dispatched are dropped, e.g. 24h. Defaults to 24h.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_gateway_v1alpha1_SensorNotificationWatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -489,10 +744,52 @@ func schema_pkg_apis_gateway_v1alpha1_SensorNotificationWatcher(ref common.Refer
 							Format:      "",
 						},
 					},
+					"routing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routing selects the events sent to the sensor. The sensor receives all the events if not set.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.WatcherRouting"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.WatcherRouting"},
+	}
+}
+
+func schema_pkg_apis_gateway_v1alpha1_WatcherRouting(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WatcherRouting selects the events of the gateway sent to a watcher. An event is sent to the watcher if it matches both the event sources and the filter.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"eventSources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventSources are the names of the event sources whose events are sent to the watcher, all event sources if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter selects the events sent to the watcher, with the semantics of the filters of sensor dependencies",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyFilter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyFilter"},
 	}
 }
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"github.com/argoproj/argo-events/pkg/apis/common"
+	sv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -33,6 +34,7 @@ const (
 	NodePhaseCompleted      NodePhase = "Completed"      // node has completed running
 	NodePhaseRemove         NodePhase = "Remove"         // stale node
 	NodePhaseResourceUpdate NodePhase = "ResourceUpdate" // resource is updated
+	NodePhaseDeliveryUpdate NodePhase = "DeliveryUpdate" // delivery status of the node is updated
 )
 
 // Gateway is the definition of a gateway resource
@@ -84,6 +86,85 @@ type GatewaySpec struct {
 
 	// EventProtocol is the underlying protocol used to send events from gateway to watchers(components interested in listening to event from this gateway)
 	EventProtocol EventProtocol `json:"eventProtocol" protobuf:"bytes,8,opt,name=eventProtocol"`
+
+	// Outbox persists the events of the gateway until they are dispatched to the watchers
+	Outbox *Outbox `json:"outbox,omitempty" protobuf:"bytes,9,opt,name=outbox"`
+
+	// RestartPolicy is the policy of the restarts of the event sources that failed
+	// +optional
+	RestartPolicy *EventSourceRestartPolicy `json:"restartPolicy,omitempty" protobuf:"bytes,10,opt,name=restartPolicy"`
+
+	// Server is the gateway server running the event sources, when it runs outside of the gateway pod.
+	// Defaults to the gateway server container of the gateway pod, listening on the processor port.
+	// +optional
+	Server *GatewayServer `json:"server,omitempty" protobuf:"bytes,11,opt,name=server"`
+}
+
+// GatewayServer is a gateway server reachable over the network, e.g. a gateway server shared by several gateways behind a service.
+type GatewayServer struct {
+	// Address of the gateway server, e.g. calendar-gateway-server.event-sources.svc:9330
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+
+	// TLS secures the connection to the gateway server. The connection is insecure without TLS.
+	// +optional
+	TLS *GatewayServerTLS `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+}
+
+// GatewayServerTLS holds the certificates of the TLS connection to a gateway server. The secrets are read from the namespace of the gateway.
+type GatewayServerTLS struct {
+	// CACertSecret refers to the CA certificate the certificate of the gateway server is verified with.
+	// Defaults to the CA certificates of the host.
+	// +optional
+	CACertSecret *corev1.SecretKeySelector `json:"caCertSecret,omitempty" protobuf:"bytes,1,opt,name=caCertSecret"`
+
+	// ClientCertSecret refers to the certificate the gateway client presents to the gateway server for mutual TLS.
+	// +optional
+	ClientCertSecret *corev1.SecretKeySelector `json:"clientCertSecret,omitempty" protobuf:"bytes,2,opt,name=clientCertSecret"`
+
+	// ClientKeySecret refers to the key of the client certificate.
+	// +optional
+	ClientKeySecret *corev1.SecretKeySelector `json:"clientKeySecret,omitempty" protobuf:"bytes,3,opt,name=clientKeySecret"`
+
+	// ServerName is the name the certificate of the gateway server is verified against. Defaults to the host of the address.
+	// +optional
+	ServerName string `json:"serverName,omitempty" protobuf:"bytes,4,opt,name=serverName"`
+}
+
+// EventSourceRestartPolicy describes how the event sources that failed are restarted.
+// Failed event sources are restarted with an exponential backoff, until they fail more than MaxRestarts times in a row.
+type EventSourceRestartPolicy struct {
+	// MaxRestarts is the number of consecutive restarts after which a failed event source is not restarted anymore.
+	// Zero disables restarts. Defaults to 10.
+	// +optional
+	MaxRestarts *int32 `json:"maxRestarts,omitempty" protobuf:"varint,1,opt,name=maxRestarts"`
+
+	// InitialDelay is the delay before the first restart, e.g. 1s. The delay doubles on each consecutive restart.
+	// Defaults to 1s.
+	// +optional
+	InitialDelay string `json:"initialDelay,omitempty" protobuf:"bytes,2,opt,name=initialDelay"`
+
+	// MaxDelay is the maximum delay between restarts, e.g. 5m. An event source running for longer than the max delay before failing
+	// is considered healthy again, and its consecutive restarts are reset.
+	// Defaults to 5m.
+	// +optional
+	MaxDelay string `json:"maxDelay,omitempty" protobuf:"bytes,3,opt,name=maxDelay"`
+}
+
+// Outbox persists the events produced by the event sources on a volume of the gateway pod before they are dispatched.
+// An event is removed from the outbox once it is dispatched to all the watchers. The events left in the outbox are dispatched again when the gateway restarts.
+type Outbox struct {
+	// Path is the directory the events are persisted in. It should be on a persistent volume mounted in the gateway client container.
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+
+	// MaxSize is the maximum size of the outbox, e.g. 100Mi. The oldest events are dropped once it is reached.
+	// Defaults to 100Mi.
+	// +optional
+	MaxSize string `json:"maxSize,omitempty" protobuf:"bytes,2,opt,name=maxSize"`
+
+	// MaxAge is the duration after which events not yet dispatched are dropped, e.g. 24h.
+	// Defaults to 24h.
+	// +optional
+	MaxAge string `json:"maxAge,omitempty" protobuf:"bytes,3,opt,name=maxAge"`
 }
 
 // GatewayStatus contains information about the status of a gateway.
@@ -127,6 +208,33 @@ type NodeStatus struct {
 
 	// UpdateTime is the time when node(gateway configuration) was updated
 	UpdateTime metav1.MicroTime `json:"updateTime,omitempty" protobuf:"bytes,9,opt,name=updateTime"`
+
+	// Delivery is the outcome of the dispatch of the events of the node to the watchers
+	Delivery *DeliveryStatus `json:"delivery,omitempty" protobuf:"bytes,10,opt,name=delivery"`
+
+	// RestartCount is the number of times the event source of the node was restarted after failing
+	RestartCount int32 `json:"restartCount,omitempty" protobuf:"varint,11,opt,name=restartCount"`
+
+	// LastError is the error of the latest failure of the event source of the node
+	LastError string `json:"lastError,omitempty" protobuf:"bytes,12,opt,name=lastError"`
+}
+
+// DeliveryStatus is the outcome of the dispatch of the events of an event source to the watchers
+type DeliveryStatus struct {
+	// Delivered is the number of events dispatched to all the watchers
+	Delivered int64 `json:"delivered" protobuf:"varint,1,opt,name=delivered"`
+
+	// Failed is the number of events that failed to be dispatched to at least one watcher
+	Failed int64 `json:"failed" protobuf:"varint,2,opt,name=failed"`
+
+	// LastError is the error of the latest failed dispatch
+	LastError string `json:"lastError,omitempty" protobuf:"bytes,3,opt,name=lastError"`
+
+	// LastFailureTime is the time of the latest failed dispatch
+	LastFailureTime metav1.MicroTime `json:"lastFailureTime,omitempty" protobuf:"bytes,4,opt,name=lastFailureTime"`
+
+	// Filtered is the number of events dropped by the filters of the event source instead of being dispatched
+	Filtered int64 `json:"filtered,omitempty" protobuf:"varint,5,opt,name=filtered"`
 }
 
 // NotificationWatchers are components which are interested listening to notifications from this gateway
@@ -149,12 +257,32 @@ type GatewayNotificationWatcher struct {
 	// Endpoint is REST API endpoint to post event to.
 	// Events are sent using HTTP POST method to this endpoint.
 	Endpoint string `json:"endpoint" protobuf:"bytes,3,opt,name=endpoint"`
+
+	// Routing selects the events sent to the gateway. The gateway receives all the events if not set.
+	// +optional
+	Routing *WatcherRouting `json:"routing,omitempty" protobuf:"bytes,4,opt,name=routing"`
 }
 
 // SensorNotificationWatcher is the sensor interested in listening to notifications from this gateway
 type SensorNotificationWatcher struct {
 	// Name is name of the sensor
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// Routing selects the events sent to the sensor. The sensor receives all the events if not set.
+	// +optional
+	Routing *WatcherRouting `json:"routing,omitempty" protobuf:"bytes,2,opt,name=routing"`
+}
+
+// WatcherRouting selects the events of the gateway sent to a watcher.
+// An event is sent to the watcher if it matches both the event sources and the filter.
+type WatcherRouting struct {
+	// EventSources are the names of the event sources whose events are sent to the watcher, all event sources if empty
+	// +optional
+	EventSources []string `json:"eventSources,omitempty" protobuf:"bytes,1,rep,name=eventSources"`
+
+	// Filter selects the events sent to the watcher, with the semantics of the filters of sensor dependencies
+	// +optional
+	Filter *sv1alpha1.EventDependencyFilter `json:"filter,omitempty" protobuf:"bytes,2,opt,name=filter"`
 }
 
 // Dispatch protocol contains configuration necessary to dispatch an event to sensor over different communication protocols
@@ -164,6 +292,16 @@ type EventProtocol struct {
 	Http Http `json:"http" protobuf:"bytes,2,opt,name=http"`
 
 	Nats Nats `json:"nats" protobuf:"bytes,3,opt,name=nats"`
+
+	// CloudEventsVersion is the version of the CloudEvents specification the events are dispatched with, 0.1 or 1.0.
+	// Defaults to 0.1, the JSON envelope sensors have always accepted.
+	// +optional
+	CloudEventsVersion common.CloudEventsSpecVersion `json:"cloudEventsVersion,omitempty" protobuf:"bytes,4,opt,name=cloudEventsVersion"`
+
+	// CloudEventsMode is the HTTP content mode of CloudEvents 1.0 events, binary or structured. Defaults to binary.
+	// Events are always structured over NATS.
+	// +optional
+	CloudEventsMode common.CloudEventsMode `json:"cloudEventsMode,omitempty" protobuf:"bytes,5,opt,name=cloudEventsMode"`
 }
 
 type Http struct {
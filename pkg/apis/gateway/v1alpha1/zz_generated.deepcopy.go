@@ -20,10 +20,28 @@ limitations under the License.
 package v1alpha1
 
 import (
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeliveryStatus) DeepCopyInto(out *DeliveryStatus) {
+	*out = *in
+	in.LastFailureTime.DeepCopyInto(&out.LastFailureTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeliveryStatus.
+func (in *DeliveryStatus) DeepCopy() *DeliveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeliveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventProtocol) DeepCopyInto(out *EventProtocol) {
 	*out = *in
@@ -42,6 +60,27 @@ func (in *EventProtocol) DeepCopy() *EventProtocol {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceRestartPolicy) DeepCopyInto(out *EventSourceRestartPolicy) {
+	*out = *in
+	if in.MaxRestarts != nil {
+		in, out := &in.MaxRestarts, &out.MaxRestarts
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceRestartPolicy.
+func (in *EventSourceRestartPolicy) DeepCopy() *EventSourceRestartPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceRestartPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Gateway) DeepCopyInto(out *Gateway) {
 	*out = *in
@@ -106,6 +145,11 @@ func (in *GatewayList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayNotificationWatcher) DeepCopyInto(out *GatewayNotificationWatcher) {
 	*out = *in
+	if in.Routing != nil {
+		in, out := &in.Routing, &out.Routing
+		*out = new(WatcherRouting)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -119,6 +163,58 @@ func (in *GatewayNotificationWatcher) DeepCopy() *GatewayNotificationWatcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayServer) DeepCopyInto(out *GatewayServer) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(GatewayServerTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayServer.
+func (in *GatewayServer) DeepCopy() *GatewayServer {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayServerTLS) DeepCopyInto(out *GatewayServerTLS) {
+	*out = *in
+	if in.CACertSecret != nil {
+		in, out := &in.CACertSecret, &out.CACertSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCertSecret != nil {
+		in, out := &in.ClientCertSecret, &out.ClientCertSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientKeySecret != nil {
+		in, out := &in.ClientKeySecret, &out.ClientKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayServerTLS.
+func (in *GatewayServerTLS) DeepCopy() *GatewayServerTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayServerTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewaySpec) DeepCopyInto(out *GatewaySpec) {
 	*out = *in
@@ -138,6 +234,21 @@ func (in *GatewaySpec) DeepCopyInto(out *GatewaySpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	out.EventProtocol = in.EventProtocol
+	if in.Outbox != nil {
+		in, out := &in.Outbox, &out.Outbox
+		*out = new(Outbox)
+		**out = **in
+	}
+	if in.RestartPolicy != nil {
+		in, out := &in.RestartPolicy, &out.RestartPolicy
+		*out = new(EventSourceRestartPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(GatewayServer)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -212,6 +323,11 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 	*out = *in
 	in.StartedAt.DeepCopyInto(&out.StartedAt)
 	in.UpdateTime.DeepCopyInto(&out.UpdateTime)
+	if in.Delivery != nil {
+		in, out := &in.Delivery, &out.Delivery
+		*out = new(DeliveryStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -231,12 +347,16 @@ func (in *NotificationWatchers) DeepCopyInto(out *NotificationWatchers) {
 	if in.Gateways != nil {
 		in, out := &in.Gateways, &out.Gateways
 		*out = make([]GatewayNotificationWatcher, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Sensors != nil {
 		in, out := &in.Sensors, &out.Sensors
 		*out = make([]SensorNotificationWatcher, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -251,9 +371,30 @@ func (in *NotificationWatchers) DeepCopy() *NotificationWatchers {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Outbox) DeepCopyInto(out *Outbox) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Outbox.
+func (in *Outbox) DeepCopy() *Outbox {
+	if in == nil {
+		return nil
+	}
+	out := new(Outbox)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SensorNotificationWatcher) DeepCopyInto(out *SensorNotificationWatcher) {
 	*out = *in
+	if in.Routing != nil {
+		in, out := &in.Routing, &out.Routing
+		*out = new(WatcherRouting)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -266,3 +407,29 @@ func (in *SensorNotificationWatcher) DeepCopy() *SensorNotificationWatcher {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatcherRouting) DeepCopyInto(out *WatcherRouting) {
+	*out = *in
+	if in.EventSources != nil {
+		in, out := &in.EventSources, &out.EventSources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(sensorv1alpha1.EventDependencyFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatcherRouting.
+func (in *WatcherRouting) DeepCopy() *WatcherRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(WatcherRouting)
+	in.DeepCopyInto(out)
+	return out
+}
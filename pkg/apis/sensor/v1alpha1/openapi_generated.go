@@ -28,32 +28,133 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Absence":                 schema_pkg_apis_sensor_v1alpha1_Absence(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Aggregation":             schema_pkg_apis_sensor_v1alpha1_Aggregation(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation":        schema_pkg_apis_sensor_v1alpha1_ArtifactLocation(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Batch":                   schema_pkg_apis_sensor_v1alpha1_Batch(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConfigMapLookup":         schema_pkg_apis_sensor_v1alpha1_ConfigMapLookup(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConfigMapSink":           schema_pkg_apis_sensor_v1alpha1_ConfigMapSink(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConfigMapStateStore":     schema_pkg_apis_sensor_v1alpha1_ConfigMapStateStore(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConfigmapArtifact":       schema_pkg_apis_sensor_v1alpha1_ConfigmapArtifact(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Data":                    schema_pkg_apis_sensor_v1alpha1_Data(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DataFilter":              schema_pkg_apis_sensor_v1alpha1_DataFilter(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DeadLetterSink":          schema_pkg_apis_sensor_v1alpha1_DeadLetterSink(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Debounce":                schema_pkg_apis_sensor_v1alpha1_Debounce(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Enrichment":              schema_pkg_apis_sensor_v1alpha1_Enrichment(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EnrichmentResults":       schema_pkg_apis_sensor_v1alpha1_EnrichmentResults(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependency":         schema_pkg_apis_sensor_v1alpha1_EventDependency(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyFilter":   schema_pkg_apis_sensor_v1alpha1_EventDependencyFilter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventProtocol":           schema_pkg_apis_sensor_v1alpha1_EventProtocol(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FanOut":                  schema_pkg_apis_sensor_v1alpha1_FanOut(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileArtifact":            schema_pkg_apis_sensor_v1alpha1_FileArtifact(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileSink":                schema_pkg_apis_sensor_v1alpha1_FileSink(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileStateStore":          schema_pkg_apis_sensor_v1alpha1_FileStateStore(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GroupVersionKind":        schema_pkg_apis_sensor_v1alpha1_GroupVersionKind(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPLookup":              schema_pkg_apis_sensor_v1alpha1_HTTPLookup(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Http":                    schema_pkg_apis_sensor_v1alpha1_Http(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HttpSink":                schema_pkg_apis_sensor_v1alpha1_HttpSink(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Nats":                    schema_pkg_apis_sensor_v1alpha1_Nats(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NatsSink":                schema_pkg_apis_sensor_v1alpha1_NatsSink(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NatsStateStore":          schema_pkg_apis_sensor_v1alpha1_NatsStateStore(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NodeStatus":              schema_pkg_apis_sensor_v1alpha1_NodeStatus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit":               schema_pkg_apis_sensor_v1alpha1_RateLimit(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ResourceLookup":          schema_pkg_apis_sensor_v1alpha1_ResourceLookup(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ResourceObject":          schema_pkg_apis_sensor_v1alpha1_ResourceObject(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ResourceParameter":       schema_pkg_apis_sensor_v1alpha1_ResourceParameter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ResourceParameterSource": schema_pkg_apis_sensor_v1alpha1_ResourceParameterSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RetryStrategy":           schema_pkg_apis_sensor_v1alpha1_RetryStrategy(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Scaling":                 schema_pkg_apis_sensor_v1alpha1_Scaling(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Sensor":                  schema_pkg_apis_sensor_v1alpha1_Sensor(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorList":              schema_pkg_apis_sensor_v1alpha1_SensorList(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorSpec":              schema_pkg_apis_sensor_v1alpha1_SensorSpec(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorStatus":            schema_pkg_apis_sensor_v1alpha1_SensorStatus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Sequence":                schema_pkg_apis_sensor_v1alpha1_Sequence(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StateStore":              schema_pkg_apis_sensor_v1alpha1_StateStore(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SuspendPolicy":           schema_pkg_apis_sensor_v1alpha1_SuspendPolicy(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TimeFilter":              schema_pkg_apis_sensor_v1alpha1_TimeFilter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger":                 schema_pkg_apis_sensor_v1alpha1_Trigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.URLArtifact":             schema_pkg_apis_sensor_v1alpha1_URLArtifact(ref),
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_Absence(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Absence describes the deadline before which an event must arrive. Only one of duration or schedule must be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration within which an event must arrive, e.g. 30m. The deadline is extended every time an event arrives.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a cron expression of the deadlines, e.g. \"0 3 * * *\". An event must arrive between two consecutive deadlines.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_Aggregation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Aggregation describes an aggregation of events over a sliding window and the threshold the aggregate must cross",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"function": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Function is the aggregation function, either Count, Sum or Average",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the JSONPath of the event's (JSON decoded) data key holding the value to aggregate. Not used for Count. See https://github.com/tidwall/gjson#path-syntax for more information on how to use this.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is the duration of the sliding window, e.g. 10m",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operator is the operator used to compare the aggregate against the threshold. Defaults to \">\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"threshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Threshold is the value the aggregate is compared against. It is parsed as float64 using strconv.ParseFloat()",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"function", "window", "threshold"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_ArtifactLocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -95,6 +196,121 @@ func schema_pkg_apis_sensor_v1alpha1_ArtifactLocation(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_Batch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Batch describes how the events of a dependency are collected into a single batch. A batch is complete when either the window has elapsed or the maximum number of events is reached, whichever comes first.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is the duration starting from the first event of the batch after which the batch is complete, e.g. 5m",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxEvents": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxEvents is the number of events after which the batch is complete",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_ConfigMapLookup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMapLookup describes a K8s configmap to read",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the configmap. Defaults to the namespace of the sensor.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the configmap",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key of the configmap data to read. The entire data is read if not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_ConfigMapSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMapSink is a configmap capped to a maximum number of entries and to the size of a configmap",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the configmap. It is created if it does not exist.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxEntries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxEntries is the maximum number of entries kept. The oldest entries are removed first. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_ConfigMapStateStore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMapStateStore is a configmap",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the configmap. It is created if it does not exist.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_ConfigmapArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -195,6 +411,155 @@ func schema_pkg_apis_sensor_v1alpha1_DataFilter(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_DeadLetterSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeadLetterSink describes where events that are rejected by filters or fail to trigger are sent, along with the reason of the failure. Only one of nats, http, configMap or file must be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nats publishes the dead letters on a subject",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NatsSink"),
+						},
+					},
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Http posts the dead letters to an endpoint",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HttpSink"),
+						},
+					},
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap stores the latest dead letters in a configmap in the namespace of the sensor",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConfigMapSink"),
+						},
+					},
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File appends the dead letters to a file on the sensor pod",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileSink"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConfigMapSink", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileSink", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HttpSink", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NatsSink"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_Debounce(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Debounce describes the quiet period after which a debounced trigger is executed",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Period is the duration without any new trigger execution after which the trigger is executed, e.g. 30s",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"period"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_Enrichment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Enrichment describes a lookup whose result is merged into the event data for the filters and the parameters of triggers. Only one of resource, http or configMap must be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is a unique name of the enrichment",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dest is the JSONPath of the event data key the result is set to for the filters and the parameters of triggers. Defaults to the name. See https://github.com/tidwall/sjson#path-syntax for more information about how this is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resource looks up a K8s object",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ResourceLookup"),
+						},
+					},
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP sends a GET request. The response body is the result.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPLookup"),
+						},
+					},
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap reads a K8s configmap",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConfigMapLookup"),
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters resolve values of the lookup from events, e.g. dest: http.query.ref The dest of a parameter is the JSONPath of the key within this enrichment.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ResourceParameter"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConfigMapLookup", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPLookup", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ResourceLookup", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ResourceParameter"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_EnrichmentResults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EnrichmentResults holds the JSON encoded results of the enrichments of an event, keyed by their dest",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"results": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_EventDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -229,12 +594,43 @@ func schema_pkg_apis_sensor_v1alpha1_EventDependency(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"batch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Batch collects the events of this dependency into a single batch. The dependency is resolved once the batch is complete.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Batch"),
+						},
+					},
+					"aggregation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Aggregation aggregates the events of this dependency over a sliding window. The dependency is resolved once the aggregate crosses the threshold.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Aggregation"),
+						},
+					},
+					"absence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Absence inverts the dependency: it is resolved when no event arrives before the deadline.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Absence"),
+						},
+					},
+					"enrichments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enrichments are lookups performed for the events before the filters are applied. Their results are kept apart from the event and merged into the event data for the filters and the parameters of triggers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Enrichment"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyFilter"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Absence", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Aggregation", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Batch", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Enrichment", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyFilter"},
 	}
 }
 
@@ -305,6 +701,13 @@ func schema_pkg_apis_sensor_v1alpha1_EventProtocol(ref common.ReferenceCallback)
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Nats"),
 						},
 					},
+					"ackAfterProcessing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AckAfterProcessing acknowledges events only once they are processed and the state of the sensor is persisted. Messages over NATS streaming are then acknowledged manually and HTTP requests are responded to once the event is processed. Events that are not acknowledged are redelivered, e.g. after a crash of the sensor.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"type", "http", "nats"},
 			},
@@ -314,6 +717,49 @@ func schema_pkg_apis_sensor_v1alpha1_EventProtocol(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_FanOut(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FanOut describes how a trigger is executed once per element of an array in the payload of an event. Resource parameters that refer to the event resolve against the element instead of the entire payload.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"event": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Event is the name of the event dependency whose payload holds the array",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the JSONPath of the array in the event's (JSON decoded) data. Defaults to the entire payload. See https://github.com/tidwall/gjson#path-syntax for more information on how to use this.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxItems is the maximum number of elements the trigger is executed for. Defaults to 100. Elements beyond the limit are dropped.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"parallelism": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parallelism is the maximum number of concurrent trigger executions. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"event"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_FileArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -334,6 +780,57 @@ func schema_pkg_apis_sensor_v1alpha1_FileArtifact(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_FileSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FileSink is a file of JSON lines capped to a maximum number of entries",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the file",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxEntries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxEntries is the maximum number of entries kept. The oldest entries are removed first. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_FileStateStore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FileStateStore is a directory",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the directory",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_GroupVersionKind(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -367,6 +864,56 @@ func schema_pkg_apis_sensor_v1alpha1_GroupVersionKind(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_HTTPLookup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPLookup describes a HTTP GET request",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL to send the request to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Query parameters appended to the URL",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers of the request",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_Http(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -389,6 +936,28 @@ func schema_pkg_apis_sensor_v1alpha1_Http(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_HttpSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HttpSink is a HTTP endpoint",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL to post to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_Nats(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -426,48 +995,127 @@ func schema_pkg_apis_sensor_v1alpha1_Nats(ref common.ReferenceCallback) common.O
 					},
 					"startAtTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subscribe starting at a specific time",
+							Description: "Subscribe starting at a specific time",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startAtTimeDelta": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subscribe starting a specific amount of time in the past (e.g. 30 seconds ago)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"durable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Durable subscriptions allow clients to assign a durable name to a subscription when it is created",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"clusterId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The NATS Streaming cluster ID",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The NATS Streaming cluster ID",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the connection. either standard or streaming",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ackWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AckWait is the time after which a message that is not acknowledged is redelivered by NATS streaming, e.g. 30s. Only applies when events are acknowledged after processing.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url", "type"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_NatsSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NatsSink is a nats subject",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is nats server/service URL",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"startAtTimeDelta": {
+					"subject": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subscribe starting a specific amount of time in the past (e.g. 30 seconds ago)",
+							Description: "Subject to publish on",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"durable": {
+				},
+				Required: []string{"url", "subject"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_NatsStateStore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NatsStateStore is a nats streaming channel",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Durable subscriptions allow clients to assign a durable name to a subscription when it is created",
-							Type:        []string{"boolean"},
+							Description: "URL is nats streaming server/service URL",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"clusterId": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The NATS Streaming cluster ID",
+							Description: "ClusterId is the id of the nats streaming cluster",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"clientId": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The NATS Streaming cluster ID",
+							Description: "ClientId is the id of the client. Must be unique within the cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"subject": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of the connection. either standard or streaming",
+							Description: "Subject is the channel of the key-value pairs",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"url", "type"},
+				Required: []string{"url", "clusterId", "clientId", "subject"},
 			},
 		},
 		Dependencies: []string{},
@@ -541,12 +1189,149 @@ func schema_pkg_apis_sensor_v1alpha1_NodeStatus(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Event"),
 						},
 					},
+					"suppressedCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuppressedCount is the count of trigger executions suppressed by rate limiting or debouncing",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"events": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Events stores the current batch of events for an event dependency that batches events",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-events/pkg/apis/common.Event"),
+									},
+								},
+							},
+						},
+					},
+					"eventRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventRef is the key of the events of the node in the state store of the sensor. The payloads of the events are omitted from the status when the events are stored in the state store.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"enrichment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enrichment stores the JSON encoded results of the enrichments of the last seen event, keyed by their dest. The event itself is left unchanged by the enrichments. The results are omitted from the status, like the payloads of the events, when the events are stored in the state store.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"batchEnrichments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchEnrichments stores the results of the enrichments of the events of the current batch, in the order of the events",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EnrichmentResults"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"id", "name", "displayName", "type", "phase"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.Event", "k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime"},
+			"github.com/argoproj/argo-events/pkg/apis/common.Event", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EnrichmentResults", "k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_RateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RateLimit describes a token bucket that limits the rate of trigger executions. Trigger executions that exceed the rate are suppressed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unit is the unit of time for the rate. Defaults to Second.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requestsPerUnit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestsPerUnit is the number of trigger executions allowed per unit of time",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the maximum number of trigger executions allowed at once. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"requestsPerUnit"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_ResourceLookup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceLookup describes a K8s object to look up",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the object. Defaults to the namespace of the sensor.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the object",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"group", "version", "kind", "name"},
+			},
+		},
+		Dependencies: []string{},
 	}
 }
 
@@ -702,6 +1487,35 @@ func schema_pkg_apis_sensor_v1alpha1_RetryStrategy(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_Scaling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Scaling describes how the events are shared among the replicas of a sensor. Events are partitioned by a correlation key, so the events to correlate reach the same replica. The replicas elect a leader, which is the only replica to persist the status of the sensor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of sensor pods",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the path of the correlation key in the event data, e.g. order.id. Required with more than one replica. Events without a key are processed by the first replica.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"replicas"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_Sensor(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -839,12 +1653,49 @@ func schema_pkg_apis_sensor_v1alpha1_SensorSpec(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventProtocol"),
 						},
 					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend pauses the execution of triggers without deleting the sensor. Events received while the sensor is suspended are handled according to the SuspendPolicy.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"suspendPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuspendPolicy governs how events received while the sensor is suspended are handled",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SuspendPolicy"),
+						},
+					},
+					"sequence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sequence requires the dependencies to be resolved in the order they are listed",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Sequence"),
+						},
+					},
+					"deadLetter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadLetter is the sink for events that are rejected by filters or fail to trigger",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DeadLetterSink"),
+						},
+					},
+					"stateStore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StateStore stores the events of the event dependencies outside of the sensor resource. The status of the sensor then only keeps references to the events and the context of the events.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StateStore"),
+						},
+					},
+					"scaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scaling runs the sensor as several replicas that share the events received over NATS",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Scaling"),
+						},
+					},
 				},
 				Required: []string{"dependencies", "triggers", "deploySpec", "eventProtocol"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependency", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventProtocol", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger", "k8s.io/api/core/v1.PodSpec"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DeadLetterSink", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependency", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventProtocol", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Scaling", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Sequence", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StateStore", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SuspendPolicy", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger", "k8s.io/api/core/v1.PodSpec"},
 	}
 }
 
@@ -910,6 +1761,95 @@ func schema_pkg_apis_sensor_v1alpha1_SensorStatus(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_Sequence(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Sequence describes an ordered resolution of the event dependencies, e.g. A then B then C within 15m",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"within": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Within is the duration between the events of the first and the last dependency of the sequence, e.g. 15m. The sequence starts over once the duration is exceeded. The sequence does not expire if not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"outOfOrderPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutOfOrderPolicy is the action to take on an event received out of order. Defaults to Ignore.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_StateStore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StateStore describes where the events of the event dependencies are stored. Only one of configMap, file or nats must be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap stores the events in a configmap in the namespace of the sensor",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConfigMapStateStore"),
+						},
+					},
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File stores the events in files in a directory of the sensor pod, e.g. on a persistent volume",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileStateStore"),
+						},
+					},
+					"nats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nats stores the events as key-value pairs on a nats streaming channel. The latest value of a key wins.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NatsStateStore"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConfigMapStateStore", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileStateStore", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NatsStateStore"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_SuspendPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SuspendPolicy describes how events received while the sensor is suspended are handled",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is the action to take on events received while the sensor is suspended. Defaults to Drop.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bufferLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BufferLimit is the maximum number of events to buffer when action is Buffer. Once the limit is reached, newly received events are dropped.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_TimeFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -971,12 +1911,30 @@ func schema_pkg_apis_sensor_v1alpha1_Trigger(ref common.ReferenceCallback) commo
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RetryStrategy"),
 						},
 					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit limits the rate at which the trigger is executed",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit"),
+						},
+					},
+					"debounce": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Debounce coalesces trigger executions and executes the trigger once with the latest events after a quiet period",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Debounce"),
+						},
+					},
+					"fanOut": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FanOut executes the trigger once per element of an array in the payload of an event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FanOut"),
+						},
+					},
 				},
 				Required: []string{"name", "retryStrategy"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ResourceObject", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RetryStrategy"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Debounce", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FanOut", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ResourceObject", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RetryStrategy"},
 	}
 }
 
@@ -29,8 +29,12 @@ import (
 type NotificationType string
 
 const (
-	EventNotification          NotificationType = "Event"
-	ResourceUpdateNotification NotificationType = "ResourceUpdate"
+	EventNotification            NotificationType = "Event"
+	ResourceUpdateNotification   NotificationType = "ResourceUpdate"
+	DebouncedTriggerNotification NotificationType = "DebouncedTrigger"
+	BatchWindowNotification      NotificationType = "BatchWindow"
+	AbsenceDeadlineNotification  NotificationType = "AbsenceDeadline"
+	ShutdownNotification         NotificationType = "Shutdown"
 )
 
 // NodeType is the type of a node
@@ -87,6 +91,165 @@ type SensorSpec struct {
 
 	// EventProtocol is the protocol through which sensor receives events from gateway
 	EventProtocol *EventProtocol `json:"eventProtocol" protobuf:"bytes,4,opt,name=eventProtocol"`
+
+	// Suspend pauses the execution of triggers without deleting the sensor.
+	// Events received while the sensor is suspended are handled according to the SuspendPolicy.
+	Suspend bool `json:"suspend,omitempty" protobuf:"varint,5,opt,name=suspend"`
+
+	// SuspendPolicy governs how events received while the sensor is suspended are handled
+	SuspendPolicy *SuspendPolicy `json:"suspendPolicy,omitempty" protobuf:"bytes,6,opt,name=suspendPolicy"`
+
+	// Sequence requires the dependencies to be resolved in the order they are listed
+	Sequence *Sequence `json:"sequence,omitempty" protobuf:"bytes,7,opt,name=sequence"`
+
+	// DeadLetter is the sink for events that are rejected by filters or fail to trigger
+	DeadLetter *DeadLetterSink `json:"deadLetter,omitempty" protobuf:"bytes,8,opt,name=deadLetter"`
+
+	// StateStore stores the events of the event dependencies outside of the sensor resource.
+	// The status of the sensor then only keeps references to the events and the context of the events.
+	StateStore *StateStore `json:"stateStore,omitempty" protobuf:"bytes,9,opt,name=stateStore"`
+
+	// Scaling runs the sensor as several replicas that share the events received over NATS
+	Scaling *Scaling `json:"scaling,omitempty" protobuf:"bytes,10,opt,name=scaling"`
+}
+
+// SuspendAction is the action taken on events received while the sensor is suspended
+type SuspendAction string
+
+// possible suspend actions
+const (
+	SuspendActionBuffer SuspendAction = "Buffer" // buffer the events and process them once the sensor is resumed
+	SuspendActionDrop   SuspendAction = "Drop"   // drop the events
+)
+
+// SuspendPolicy describes how events received while the sensor is suspended are handled
+type SuspendPolicy struct {
+	// Action is the action to take on events received while the sensor is suspended. Defaults to Drop.
+	Action SuspendAction `json:"action,omitempty" protobuf:"bytes,1,opt,name=action"`
+
+	// BufferLimit is the maximum number of events to buffer when action is Buffer.
+	// Once the limit is reached, newly received events are dropped.
+	BufferLimit int32 `json:"bufferLimit,omitempty" protobuf:"varint,2,opt,name=bufferLimit"`
+}
+
+// OutOfOrderPolicy is the action taken on an event that is received out of the order of a sequence
+type OutOfOrderPolicy string
+
+// possible out of order policies
+const (
+	OutOfOrderIgnore OutOfOrderPolicy = "Ignore" // ignore the event and keep the sequence
+	OutOfOrderReset  OutOfOrderPolicy = "Reset"  // ignore the event and start the sequence over
+)
+
+// Sequence describes an ordered resolution of the event dependencies, e.g. A then B then C within 15m
+type Sequence struct {
+	// Within is the duration between the events of the first and the last dependency of the sequence, e.g. 15m.
+	// The sequence starts over once the duration is exceeded. The sequence does not expire if not specified.
+	Within string `json:"within,omitempty" protobuf:"bytes,1,opt,name=within"`
+
+	// OutOfOrderPolicy is the action to take on an event received out of order. Defaults to Ignore.
+	OutOfOrderPolicy OutOfOrderPolicy `json:"outOfOrderPolicy,omitempty" protobuf:"bytes,2,opt,name=outOfOrderPolicy"`
+}
+
+// DeadLetterSink describes where events that are rejected by filters or fail to trigger are sent,
+// along with the reason of the failure. Only one of nats, http, configMap or file must be specified.
+type DeadLetterSink struct {
+	// Nats publishes the dead letters on a subject
+	Nats *NatsSink `json:"nats,omitempty" protobuf:"bytes,1,opt,name=nats"`
+
+	// Http posts the dead letters to an endpoint
+	Http *HttpSink `json:"http,omitempty" protobuf:"bytes,2,opt,name=http"`
+
+	// ConfigMap stores the latest dead letters in a configmap in the namespace of the sensor
+	ConfigMap *ConfigMapSink `json:"configMap,omitempty" protobuf:"bytes,3,opt,name=configMap"`
+
+	// File appends the dead letters to a file on the sensor pod
+	File *FileSink `json:"file,omitempty" protobuf:"bytes,4,opt,name=file"`
+}
+
+// NatsSink is a nats subject
+type NatsSink struct {
+	// URL is nats server/service URL
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+
+	// Subject to publish on
+	Subject string `json:"subject" protobuf:"bytes,2,opt,name=subject"`
+}
+
+// HttpSink is a HTTP endpoint
+type HttpSink struct {
+	// URL to post to
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+}
+
+// ConfigMapSink is a configmap capped to a maximum number of entries and to the size of a configmap
+type ConfigMapSink struct {
+	// Name of the configmap. It is created if it does not exist.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// MaxEntries is the maximum number of entries kept. The oldest entries are removed first. Defaults to 100.
+	MaxEntries int32 `json:"maxEntries,omitempty" protobuf:"varint,2,opt,name=maxEntries"`
+}
+
+// FileSink is a file of JSON lines capped to a maximum number of entries
+type FileSink struct {
+	// Path of the file
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+
+	// MaxEntries is the maximum number of entries kept. The oldest entries are removed first. Defaults to 100.
+	MaxEntries int32 `json:"maxEntries,omitempty" protobuf:"varint,2,opt,name=maxEntries"`
+}
+
+// StateStore describes where the events of the event dependencies are stored.
+// Only one of configMap, file or nats must be specified.
+type StateStore struct {
+	// ConfigMap stores the events in a configmap in the namespace of the sensor
+	ConfigMap *ConfigMapStateStore `json:"configMap,omitempty" protobuf:"bytes,1,opt,name=configMap"`
+
+	// File stores the events in files in a directory of the sensor pod, e.g. on a persistent volume
+	File *FileStateStore `json:"file,omitempty" protobuf:"bytes,2,opt,name=file"`
+
+	// Nats stores the events as key-value pairs on a nats streaming channel. The latest value of a key wins.
+	Nats *NatsStateStore `json:"nats,omitempty" protobuf:"bytes,3,opt,name=nats"`
+}
+
+// ConfigMapStateStore is a configmap
+type ConfigMapStateStore struct {
+	// Name of the configmap. It is created if it does not exist.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+}
+
+// FileStateStore is a directory
+type FileStateStore struct {
+	// Path of the directory
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+}
+
+// NatsStateStore is a nats streaming channel
+type NatsStateStore struct {
+	// URL is nats streaming server/service URL
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+
+	// ClusterId is the id of the nats streaming cluster
+	ClusterId string `json:"clusterId" protobuf:"bytes,2,opt,name=clusterId"`
+
+	// ClientId is the id of the client. Must be unique within the cluster.
+	ClientId string `json:"clientId" protobuf:"bytes,3,opt,name=clientId"`
+
+	// Subject is the channel of the key-value pairs
+	Subject string `json:"subject" protobuf:"bytes,4,opt,name=subject"`
+}
+
+// Scaling describes how the events are shared among the replicas of a sensor.
+// Events are partitioned by a correlation key, so the events to correlate reach the same replica.
+// The replicas elect a leader, which is the only replica to persist the status of the sensor.
+type Scaling struct {
+	// Replicas is the number of sensor pods
+	Replicas int32 `json:"replicas" protobuf:"varint,1,opt,name=replicas"`
+
+	// Key is the path of the correlation key in the event data, e.g. order.id. Required with more than one replica.
+	// Events without a key are processed by the first replica.
+	Key string `json:"key,omitempty" protobuf:"bytes,3,opt,name=key"`
 }
 
 // EventProtocol contains configuration necessary to receieve an event from gateway over different communication protocols
@@ -99,6 +262,11 @@ type EventProtocol struct {
 
 	// Nats contains the information required to connect to nats server and get subscriptions
 	Nats Nats `json:"nats" protobuf:"bytes,3,opt,name=nats"`
+
+	// AckAfterProcessing acknowledges events only once they are processed and the state of the sensor is persisted.
+	// Messages over NATS streaming are then acknowledged manually and HTTP requests are responded to once the event is processed.
+	// Events that are not acknowledged are redelivered, e.g. after a crash of the sensor.
+	AckAfterProcessing bool `json:"ackAfterProcessing,omitempty" protobuf:"varint,4,opt,name=ackAfterProcessing"`
 }
 
 // Http contains the information required to setup a http server and listen to incoming events
@@ -138,6 +306,10 @@ type Nats struct {
 
 	// Type of the connection. either standard or streaming
 	Type common.NatsType `json:"type" protobuf:"bytes,10,opt,name=type"`
+
+	// AckWait is the time after which a message that is not acknowledged is redelivered by NATS streaming, e.g. 30s.
+	// Only applies when events are acknowledged after processing.
+	AckWait string `json:"ackWait,omitempty" protobuf:"bytes,11,opt,name=ackWait"`
 }
 
 // EventDependency describes a dependency
@@ -157,6 +329,144 @@ type EventDependency struct {
 
 	// Connected tells if subscription is already setup in case of nats protocol.
 	Connected bool `json:"connected,omitempty" protobuf:"bytes,4,opt,name=connected"`
+
+	// Batch collects the events of this dependency into a single batch.
+	// The dependency is resolved once the batch is complete.
+	Batch *Batch `json:"batch,omitempty" protobuf:"bytes,5,opt,name=batch"`
+
+	// Aggregation aggregates the events of this dependency over a sliding window.
+	// The dependency is resolved once the aggregate crosses the threshold.
+	Aggregation *Aggregation `json:"aggregation,omitempty" protobuf:"bytes,6,opt,name=aggregation"`
+
+	// Absence inverts the dependency: it is resolved when no event arrives before the deadline.
+	Absence *Absence `json:"absence,omitempty" protobuf:"bytes,7,opt,name=absence"`
+
+	// Enrichments are lookups performed for the events before the filters are applied.
+	// Their results are kept apart from the event and merged into the event data for the filters and the parameters of triggers.
+	Enrichments []Enrichment `json:"enrichments,omitempty" protobuf:"bytes,8,rep,name=enrichments"`
+}
+
+// Batch describes how the events of a dependency are collected into a single batch.
+// A batch is complete when either the window has elapsed or the maximum number of events is reached, whichever comes first.
+type Batch struct {
+	// Window is the duration starting from the first event of the batch after which the batch is complete, e.g. 5m
+	Window string `json:"window,omitempty" protobuf:"bytes,1,opt,name=window"`
+
+	// MaxEvents is the number of events after which the batch is complete
+	MaxEvents int32 `json:"maxEvents,omitempty" protobuf:"varint,2,opt,name=maxEvents"`
+}
+
+// AggregationFunction is the function used to aggregate events
+type AggregationFunction string
+
+// the various supported aggregation functions
+const (
+	AggregationCount   AggregationFunction = "Count"   // number of events
+	AggregationSum     AggregationFunction = "Sum"     // sum of the values at the path
+	AggregationAverage AggregationFunction = "Average" // average of the values at the path
+)
+
+// ComparisonOperator is the operator used to compare a value against a threshold
+type ComparisonOperator string
+
+// the various supported comparison operators
+const (
+	GreaterThan          ComparisonOperator = ">"
+	GreaterThanOrEqualTo ComparisonOperator = ">="
+	LessThan             ComparisonOperator = "<"
+	LessThanOrEqualTo    ComparisonOperator = "<="
+	EqualTo              ComparisonOperator = "=="
+)
+
+// Aggregation describes an aggregation of events over a sliding window and the threshold the aggregate must cross
+type Aggregation struct {
+	// Function is the aggregation function, either Count, Sum or Average
+	Function AggregationFunction `json:"function" protobuf:"bytes,1,opt,name=function"`
+
+	// Path is the JSONPath of the event's (JSON decoded) data key holding the value to aggregate.
+	// Not used for Count.
+	// See https://github.com/tidwall/gjson#path-syntax for more information on how to use this.
+	Path string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
+
+	// Window is the duration of the sliding window, e.g. 10m
+	Window string `json:"window" protobuf:"bytes,3,opt,name=window"`
+
+	// Operator is the operator used to compare the aggregate against the threshold. Defaults to ">".
+	Operator ComparisonOperator `json:"operator,omitempty" protobuf:"bytes,4,opt,name=operator"`
+
+	// Threshold is the value the aggregate is compared against.
+	// It is parsed as float64 using strconv.ParseFloat()
+	Threshold string `json:"threshold" protobuf:"bytes,5,opt,name=threshold"`
+}
+
+// Absence describes the deadline before which an event must arrive. Only one of duration or schedule must be specified.
+type Absence struct {
+	// Duration within which an event must arrive, e.g. 30m. The deadline is extended every time an event arrives.
+	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
+
+	// Schedule is a cron expression of the deadlines, e.g. "0 3 * * *".
+	// An event must arrive between two consecutive deadlines.
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,2,opt,name=schedule"`
+}
+
+// Enrichment describes a lookup whose result is merged into the event data for the filters and the parameters of triggers.
+// Only one of resource, http or configMap must be specified.
+type Enrichment struct {
+	// Name is a unique name of the enrichment
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// Dest is the JSONPath of the event data key the result is set to for the filters and the parameters of triggers. Defaults to the name.
+	// See https://github.com/tidwall/sjson#path-syntax for more information about how this is used.
+	Dest string `json:"dest,omitempty" protobuf:"bytes,2,opt,name=dest"`
+
+	// Resource looks up a K8s object
+	Resource *ResourceLookup `json:"resource,omitempty" protobuf:"bytes,3,opt,name=resource"`
+
+	// HTTP sends a GET request. The response body is the result.
+	HTTP *HTTPLookup `json:"http,omitempty" protobuf:"bytes,4,opt,name=http"`
+
+	// ConfigMap reads a K8s configmap
+	ConfigMap *ConfigMapLookup `json:"configMap,omitempty" protobuf:"bytes,5,opt,name=configMap"`
+
+	// Parameters resolve values of the lookup from events, e.g. dest: http.query.ref
+	// The dest of a parameter is the JSONPath of the key within this enrichment.
+	Parameters []ResourceParameter `json:"parameters,omitempty" protobuf:"bytes,6,rep,name=parameters"`
+}
+
+// ResourceLookup describes a K8s object to look up
+type ResourceLookup struct {
+	// The unambiguous kind of the object
+	GroupVersionKind `json:",inline" protobuf:"bytes,3,opt,name=groupVersionKind"`
+
+	// Namespace of the object. Defaults to the namespace of the sensor.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+
+	// Name of the object
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+}
+
+// HTTPLookup describes a HTTP GET request
+type HTTPLookup struct {
+	// URL to send the request to
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+
+	// Query parameters appended to the URL
+	Query map[string]string `json:"query,omitempty" protobuf:"bytes,2,rep,name=query"`
+
+	// Headers of the request
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+}
+
+// ConfigMapLookup describes a K8s configmap to read
+type ConfigMapLookup struct {
+	// Namespace of the configmap. Defaults to the namespace of the sensor.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+
+	// Name of the configmap
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+
+	// Key of the configmap data to read. The entire data is read if not specified.
+	Key string `json:"key,omitempty" protobuf:"bytes,3,opt,name=key"`
 }
 
 // GroupVersionKind unambiguously identifies a kind.  It doesn't anonymously include GroupVersion
@@ -247,6 +557,63 @@ type Trigger struct {
 
 	// RetryStrategy is the strategy to retry a trigger if it fails
 	RetryStrategy *RetryStrategy `json:"retryStrategy" protobuf:"bytes,4,opt,name=replyStrategy"`
+
+	// RateLimit limits the rate at which the trigger is executed
+	RateLimit *RateLimit `json:"rateLimit,omitempty" protobuf:"bytes,5,opt,name=rateLimit"`
+
+	// Debounce coalesces trigger executions and executes the trigger once with the latest events
+	// after a quiet period
+	Debounce *Debounce `json:"debounce,omitempty" protobuf:"bytes,6,opt,name=debounce"`
+
+	// FanOut executes the trigger once per element of an array in the payload of an event
+	FanOut *FanOut `json:"fanOut,omitempty" protobuf:"bytes,7,opt,name=fanOut"`
+}
+
+// RateLimitUnit is the unit of time for a rate limit
+type RateLimitUnit string
+
+// possible rate limit units
+const (
+	RateLimitUnitSecond RateLimitUnit = "Second"
+	RateLimitUnitMinute RateLimitUnit = "Minute"
+	RateLimitUnitHour   RateLimitUnit = "Hour"
+)
+
+// RateLimit describes a token bucket that limits the rate of trigger executions.
+// Trigger executions that exceed the rate are suppressed.
+type RateLimit struct {
+	// Unit is the unit of time for the rate. Defaults to Second.
+	Unit RateLimitUnit `json:"unit,omitempty" protobuf:"bytes,1,opt,name=unit"`
+
+	// RequestsPerUnit is the number of trigger executions allowed per unit of time
+	RequestsPerUnit int32 `json:"requestsPerUnit" protobuf:"varint,2,opt,name=requestsPerUnit"`
+
+	// Burst is the maximum number of trigger executions allowed at once. Defaults to 1.
+	Burst int32 `json:"burst,omitempty" protobuf:"varint,3,opt,name=burst"`
+}
+
+// Debounce describes the quiet period after which a debounced trigger is executed
+type Debounce struct {
+	// Period is the duration without any new trigger execution after which the trigger is executed, e.g. 30s
+	Period string `json:"period" protobuf:"bytes,1,opt,name=period"`
+}
+
+// FanOut describes how a trigger is executed once per element of an array in the payload of an event.
+// Resource parameters that refer to the event resolve against the element instead of the entire payload.
+type FanOut struct {
+	// Event is the name of the event dependency whose payload holds the array
+	Event string `json:"event" protobuf:"bytes,1,opt,name=event"`
+
+	// Path is the JSONPath of the array in the event's (JSON decoded) data. Defaults to the entire payload.
+	// See https://github.com/tidwall/gjson#path-syntax for more information on how to use this.
+	Path string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
+
+	// MaxItems is the maximum number of elements the trigger is executed for. Defaults to 100.
+	// Elements beyond the limit are dropped.
+	MaxItems int32 `json:"maxItems,omitempty" protobuf:"varint,3,opt,name=maxItems"`
+
+	// Parallelism is the maximum number of concurrent trigger executions. Defaults to 1.
+	Parallelism int32 `json:"parallelism,omitempty" protobuf:"varint,4,opt,name=parallelism"`
 }
 
 // ResourceParameter indicates a passed parameter to a service template
@@ -355,6 +722,29 @@ type NodeStatus struct {
 
 	// Event stores the last seen event for this node
 	Event *apicommon.Event `json:"event,omitempty" protobuf:"bytes,9,opt,name=event"`
+
+	// SuppressedCount is the count of trigger executions suppressed by rate limiting or debouncing
+	SuppressedCount int32 `json:"suppressedCount,omitempty" protobuf:"varint,10,opt,name=suppressedCount"`
+
+	// Events stores the current batch of events for an event dependency that batches events
+	Events []apicommon.Event `json:"events,omitempty" protobuf:"bytes,11,rep,name=events"`
+
+	// EventRef is the key of the events of the node in the state store of the sensor.
+	// The payloads of the events are omitted from the status when the events are stored in the state store.
+	EventRef string `json:"eventRef,omitempty" protobuf:"bytes,12,opt,name=eventRef"`
+
+	// Enrichment stores the JSON encoded results of the enrichments of the last seen event, keyed by their dest.
+	// The event itself is left unchanged by the enrichments. The results are omitted from the status, like the payloads
+	// of the events, when the events are stored in the state store.
+	Enrichment map[string]string `json:"enrichment,omitempty" protobuf:"bytes,13,rep,name=enrichment"`
+
+	// BatchEnrichments stores the results of the enrichments of the events of the current batch, in the order of the events
+	BatchEnrichments []EnrichmentResults `json:"batchEnrichments,omitempty" protobuf:"bytes,14,rep,name=batchEnrichments"`
+}
+
+// EnrichmentResults holds the JSON encoded results of the enrichments of an event, keyed by their dest
+type EnrichmentResults struct {
+	Results map[string]string `json:"results,omitempty" protobuf:"bytes,1,rep,name=results"`
 }
 
 // ArtifactLocation describes the source location for an external artifact
@@ -25,6 +25,38 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Absence) DeepCopyInto(out *Absence) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Absence.
+func (in *Absence) DeepCopy() *Absence {
+	if in == nil {
+		return nil
+	}
+	out := new(Absence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Aggregation) DeepCopyInto(out *Aggregation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Aggregation.
+func (in *Aggregation) DeepCopy() *Aggregation {
+	if in == nil {
+		return nil
+	}
+	out := new(Aggregation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 	*out = *in
@@ -66,6 +98,70 @@ func (in *ArtifactLocation) DeepCopy() *ArtifactLocation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Batch) DeepCopyInto(out *Batch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Batch.
+func (in *Batch) DeepCopy() *Batch {
+	if in == nil {
+		return nil
+	}
+	out := new(Batch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapLookup) DeepCopyInto(out *ConfigMapLookup) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapLookup.
+func (in *ConfigMapLookup) DeepCopy() *ConfigMapLookup {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapLookup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapSink) DeepCopyInto(out *ConfigMapSink) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapSink.
+func (in *ConfigMapSink) DeepCopy() *ConfigMapSink {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapStateStore) DeepCopyInto(out *ConfigMapStateStore) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapStateStore.
+func (in *ConfigMapStateStore) DeepCopy() *ConfigMapStateStore {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapStateStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigmapArtifact) DeepCopyInto(out *ConfigmapArtifact) {
 	*out = *in
@@ -125,10 +221,145 @@ func (in *DataFilter) DeepCopy() *DataFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadLetterSink) DeepCopyInto(out *DeadLetterSink) {
+	*out = *in
+	if in.Nats != nil {
+		in, out := &in.Nats, &out.Nats
+		*out = new(NatsSink)
+		**out = **in
+	}
+	if in.Http != nil {
+		in, out := &in.Http, &out.Http
+		*out = new(HttpSink)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapSink)
+		**out = **in
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileSink)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetterSink.
+func (in *DeadLetterSink) DeepCopy() *DeadLetterSink {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadLetterSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Debounce) DeepCopyInto(out *Debounce) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Debounce.
+func (in *Debounce) DeepCopy() *Debounce {
+	if in == nil {
+		return nil
+	}
+	out := new(Debounce)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Enrichment) DeepCopyInto(out *Enrichment) {
+	*out = *in
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(ResourceLookup)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPLookup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapLookup)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ResourceParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Enrichment.
+func (in *Enrichment) DeepCopy() *Enrichment {
+	if in == nil {
+		return nil
+	}
+	out := new(Enrichment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnrichmentResults) DeepCopyInto(out *EnrichmentResults) {
+	*out = *in
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnrichmentResults.
+func (in *EnrichmentResults) DeepCopy() *EnrichmentResults {
+	if in == nil {
+		return nil
+	}
+	out := new(EnrichmentResults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventDependency) DeepCopyInto(out *EventDependency) {
 	*out = *in
 	in.Filters.DeepCopyInto(&out.Filters)
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(Batch)
+		**out = **in
+	}
+	if in.Aggregation != nil {
+		in, out := &in.Aggregation, &out.Aggregation
+		*out = new(Aggregation)
+		**out = **in
+	}
+	if in.Absence != nil {
+		in, out := &in.Absence, &out.Absence
+		*out = new(Absence)
+		**out = **in
+	}
+	if in.Enrichments != nil {
+		in, out := &in.Enrichments, &out.Enrichments
+		*out = make([]Enrichment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -191,6 +422,22 @@ func (in *EventProtocol) DeepCopy() *EventProtocol {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FanOut) DeepCopyInto(out *FanOut) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FanOut.
+func (in *FanOut) DeepCopy() *FanOut {
+	if in == nil {
+		return nil
+	}
+	out := new(FanOut)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileArtifact) DeepCopyInto(out *FileArtifact) {
 	*out = *in
@@ -207,6 +454,38 @@ func (in *FileArtifact) DeepCopy() *FileArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSink) DeepCopyInto(out *FileSink) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSink.
+func (in *FileSink) DeepCopy() *FileSink {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileStateStore) DeepCopyInto(out *FileStateStore) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileStateStore.
+func (in *FileStateStore) DeepCopy() *FileStateStore {
+	if in == nil {
+		return nil
+	}
+	out := new(FileStateStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupVersionKind) DeepCopyInto(out *GroupVersionKind) {
 	*out = *in
@@ -223,6 +502,36 @@ func (in *GroupVersionKind) DeepCopy() *GroupVersionKind {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPLookup) DeepCopyInto(out *HTTPLookup) {
+	*out = *in
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPLookup.
+func (in *HTTPLookup) DeepCopy() *HTTPLookup {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPLookup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Http) DeepCopyInto(out *Http) {
 	*out = *in
@@ -239,6 +548,22 @@ func (in *Http) DeepCopy() *Http {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpSink) DeepCopyInto(out *HttpSink) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpSink.
+func (in *HttpSink) DeepCopy() *HttpSink {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Nats) DeepCopyInto(out *Nats) {
 	*out = *in
@@ -255,6 +580,38 @@ func (in *Nats) DeepCopy() *Nats {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatsSink) DeepCopyInto(out *NatsSink) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatsSink.
+func (in *NatsSink) DeepCopy() *NatsSink {
+	if in == nil {
+		return nil
+	}
+	out := new(NatsSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatsStateStore) DeepCopyInto(out *NatsStateStore) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatsStateStore.
+func (in *NatsStateStore) DeepCopy() *NatsStateStore {
+	if in == nil {
+		return nil
+	}
+	out := new(NatsStateStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 	*out = *in
@@ -265,6 +622,27 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 		*out = new(common.Event)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]common.Event, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Enrichment != nil {
+		in, out := &in.Enrichment, &out.Enrichment
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BatchEnrichments != nil {
+		in, out := &in.BatchEnrichments, &out.BatchEnrichments
+		*out = make([]EnrichmentResults, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -278,6 +656,39 @@ func (in *NodeStatus) DeepCopy() *NodeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimit) DeepCopyInto(out *RateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
+func (in *RateLimit) DeepCopy() *RateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceLookup) DeepCopyInto(out *ResourceLookup) {
+	*out = *in
+	out.GroupVersionKind = in.GroupVersionKind
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceLookup.
+func (in *ResourceLookup) DeepCopy() *ResourceLookup {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceLookup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceObject) DeepCopyInto(out *ResourceObject) {
 	*out = *in
@@ -368,6 +779,22 @@ func (in *RetryStrategy) DeepCopy() *RetryStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Scaling) DeepCopyInto(out *Scaling) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scaling.
+func (in *Scaling) DeepCopy() *Scaling {
+	if in == nil {
+		return nil
+	}
+	out := new(Scaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sensor) DeepCopyInto(out *Sensor) {
 	*out = *in
@@ -456,6 +883,31 @@ func (in *SensorSpec) DeepCopyInto(out *SensorSpec) {
 		*out = new(EventProtocol)
 		**out = **in
 	}
+	if in.SuspendPolicy != nil {
+		in, out := &in.SuspendPolicy, &out.SuspendPolicy
+		*out = new(SuspendPolicy)
+		**out = **in
+	}
+	if in.Sequence != nil {
+		in, out := &in.Sequence, &out.Sequence
+		*out = new(Sequence)
+		**out = **in
+	}
+	if in.DeadLetter != nil {
+		in, out := &in.DeadLetter, &out.DeadLetter
+		*out = new(DeadLetterSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StateStore != nil {
+		in, out := &in.StateStore, &out.StateStore
+		*out = new(StateStore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scaling != nil {
+		in, out := &in.Scaling, &out.Scaling
+		*out = new(Scaling)
+		**out = **in
+	}
 	return
 }
 
@@ -494,6 +946,69 @@ func (in *SensorStatus) DeepCopy() *SensorStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Sequence) DeepCopyInto(out *Sequence) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sequence.
+func (in *Sequence) DeepCopy() *Sequence {
+	if in == nil {
+		return nil
+	}
+	out := new(Sequence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StateStore) DeepCopyInto(out *StateStore) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapStateStore)
+		**out = **in
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileStateStore)
+		**out = **in
+	}
+	if in.Nats != nil {
+		in, out := &in.Nats, &out.Nats
+		*out = new(NatsStateStore)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateStore.
+func (in *StateStore) DeepCopy() *StateStore {
+	if in == nil {
+		return nil
+	}
+	out := new(StateStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuspendPolicy) DeepCopyInto(out *SuspendPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuspendPolicy.
+func (in *SuspendPolicy) DeepCopy() *SuspendPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SuspendPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeFilter) DeepCopyInto(out *TimeFilter) {
 	*out = *in
@@ -523,6 +1038,21 @@ func (in *Trigger) DeepCopyInto(out *Trigger) {
 		*out = new(RetryStrategy)
 		**out = **in
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RateLimit)
+		**out = **in
+	}
+	if in.Debounce != nil {
+		in, out := &in.Debounce, &out.Debounce
+		*out = new(Debounce)
+		**out = **in
+	}
+	if in.FanOut != nil {
+		in, out := &in.FanOut, &out.FanOut
+		*out = new(FanOut)
+		**out = **in
+	}
 	return
 }
 
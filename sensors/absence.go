@@ -0,0 +1,176 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	sn "github.com/argoproj/argo-events/controllers/sensor"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	suuid "github.com/satori/go.uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// absenceEventType is the event type of the events generated for resolved absence event dependencies
+const absenceEventType = "absence"
+
+// absenceDeadline is the pending deadline of an absence event dependency
+type absenceDeadline struct {
+	// deadline before which an event must arrive
+	deadline time.Time
+	// timer notifies the sensor once the deadline has passed
+	timer *time.Timer
+	// received indicates an event has arrived before the deadline
+	received bool
+}
+
+// armAbsenceDeadlines arms the deadlines of the absence event dependencies that don't have one yet
+// and disarms the deadlines of the event dependencies that are no longer absence event dependencies
+func (sec *sensorExecutionCtx) armAbsenceDeadlines() {
+	absences := make(map[string]*v1alpha1.Absence)
+	for _, dependency := range sec.sensor.Spec.Dependencies {
+		if dependency.Absence != nil {
+			absences[dependency.Name] = dependency.Absence
+		}
+	}
+	for name, deadline := range sec.absences {
+		if _, ok := absences[name]; !ok {
+			deadline.timer.Stop()
+			delete(sec.absences, name)
+		}
+	}
+	for name, absence := range absences {
+		if _, ok := sec.absences[name]; !ok {
+			sec.armAbsenceDeadline(name, absence, time.Now().UTC())
+		}
+	}
+}
+
+// armAbsenceDeadline arms the next deadline after the given time for the absence event dependency
+func (sec *sensorExecutionCtx) armAbsenceDeadline(name string, absence *v1alpha1.Absence, from time.Time) {
+	schedule, err := common.ResolveSchedule(absence.Schedule, absence.Duration)
+	if err != nil {
+		sec.log.Error().Err(err).Str("event-dependency-name", name).Msg("failed to resolve absence deadline")
+		sn.MarkNodePhase(sec.sensor, name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseError, nil, &sec.log, fmt.Sprintf("failed to resolve absence deadline. err: %+v", err))
+		return
+	}
+
+	if previous, ok := sec.absences[name]; ok {
+		previous.timer.Stop()
+	}
+
+	deadline := &absenceDeadline{
+		deadline: schedule.Next(from),
+	}
+	deadline.timer = time.AfterFunc(time.Until(deadline.deadline), func() {
+		sec.enqueue(&updateNotification{
+			notificationType: v1alpha1.AbsenceDeadlineNotification,
+			eventDependency:  &v1alpha1.EventDependency{Name: name},
+			absence:          deadline,
+		})
+	})
+	sec.absences[name] = deadline
+
+	if node := sn.GetNodeByName(sec.sensor, name); node != nil && node.Phase != v1alpha1.NodePhaseComplete {
+		node.Message = fmt.Sprintf("waiting for an event until %s", deadline.deadline.Format(time.RFC3339))
+		sec.sensor.Status.Nodes[node.ID] = *node
+	}
+	sec.log.Info().Str("event-dependency-name", name).Str("deadline", deadline.deadline.String()).Msg("armed absence deadline")
+}
+
+// recordPresence records the arrival of an event for the absence event dependency.
+// A duration deadline is extended, a scheduled deadline is met.
+func (sec *sensorExecutionCtx) recordPresence(dependency *v1alpha1.EventDependency) {
+	if dependency.Absence.Schedule == "" {
+		sec.armAbsenceDeadline(dependency.Name, dependency.Absence, time.Now().UTC())
+		return
+	}
+	deadline, ok := sec.absences[dependency.Name]
+	if !ok {
+		sec.armAbsenceDeadline(dependency.Name, dependency.Absence, time.Now().UTC())
+		return
+	}
+	deadline.received = true
+	if node := sn.GetNodeByName(sec.sensor, dependency.Name); node != nil && node.Phase != v1alpha1.NodePhaseComplete {
+		node.Message = fmt.Sprintf("event received before deadline %s", deadline.deadline.Format(time.RFC3339))
+		sec.sensor.Status.Nodes[node.ID] = *node
+	}
+	sec.log.Info().Str("event-dependency-name", dependency.Name).Msg("event received before absence deadline")
+}
+
+// completeAbsenceDeadline resolves the absence event dependency if no event has arrived before the deadline and arms the next deadline.
+// Deadlines that have been re-armed or disarmed in the meantime are ignored.
+func (sec *sensorExecutionCtx) completeAbsenceDeadline(name string, deadline *absenceDeadline) {
+	if current, ok := sec.absences[name]; !ok || current != deadline {
+		sec.log.Info().Str("event-dependency-name", name).Msg("absence deadline is stale")
+		return
+	}
+
+	var absence *v1alpha1.Absence
+	for _, dependency := range sec.sensor.Spec.Dependencies {
+		if dependency.Name == name {
+			absence = dependency.Absence
+		}
+	}
+	if absence == nil {
+		delete(sec.absences, name)
+		return
+	}
+
+	if !deadline.received {
+		event, err := absenceEvent(name, deadline.deadline)
+		if err != nil {
+			sec.log.Error().Err(err).Str("event-dependency-name", name).Msg("failed to generate absence event")
+			sn.MarkNodePhase(sec.sensor, name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseError, nil, &sec.log, fmt.Sprintf("failed to generate absence event. err: %+v", err))
+		} else {
+			sn.MarkNodePhase(sec.sensor, name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, event, &sec.log,
+				fmt.Sprintf("no event received before deadline %s", deadline.deadline.Format(time.RFC3339)))
+
+			// check if all event dependencies are complete and kick-off triggers
+			sec.processTriggers()
+		}
+	}
+
+	sec.armAbsenceDeadline(name, absence, deadline.deadline)
+}
+
+// absenceEvent generates the event of a resolved absence event dependency. The payload holds the missed deadline.
+func absenceEvent(name string, deadline time.Time) (*apicommon.Event, error) {
+	payload, err := json.Marshal(map[string]string{
+		"deadline": deadline.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apicommon.Event{
+		Context: apicommon.EventContext{
+			CloudEventsVersion: common.CloudEventsVersion,
+			EventID:            fmt.Sprintf("%x", suuid.NewV1()),
+			ContentType:        common.MediaTypeJSON,
+			EventTime:          metav1.MicroTime{Time: time.Now().UTC()},
+			EventType:          absenceEventType,
+			Source: &apicommon.URI{
+				Host: name,
+			},
+		},
+		Payload: payload,
+	}, nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"testing"
+	"time"
+
+	sensor2 "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestAbsenceDeadline(t *testing.T) {
+	convey.Convey("Given a sensor with an absence event dependency", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sensor.Spec.Dependencies[0].Absence = &v1alpha1.Absence{
+			Schedule: "0 3 * * *",
+		}
+		sec := getsensorExecutionCtx(sensor)
+		sec.sensor, err = sec.sensorClient.ArgoprojV1alpha1().Sensors(sensor.Namespace).Create(sensor)
+		convey.So(err, convey.ShouldBeNil)
+
+		sensor2.InitializeNode(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+		sensor2.MarkNodePhase(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseActive, nil, &sec.log, "node is active")
+		sensor2.InitializeNode(sec.sensor, "test-workflow-trigger", v1alpha1.NodeTypeTrigger, &sec.log, "trigger is init")
+
+		dependency := sec.sensor.Spec.Dependencies[0]
+		sec.armAbsenceDeadlines()
+		deadline := sec.absences[dependency.Name]
+		convey.So(deadline, convey.ShouldNotBeNil)
+
+		convey.Convey("A missed deadline must resolve the dependency and arm the next deadline", func() {
+			sec.completeAbsenceDeadline(dependency.Name, deadline)
+			convey.So(sec.sensor.Status.CompletionCount, convey.ShouldEqual, 1)
+			convey.So(sec.absences[dependency.Name].deadline, convey.ShouldEqual, deadline.deadline.Add(24*time.Hour))
+		})
+
+		convey.Convey("A missed deadline of a suspended sensor must not execute triggers until the sensor is resumed", func() {
+			sec.sensor.Spec.Suspend = true
+			sec.completeAbsenceDeadline(dependency.Name, deadline)
+			node := sensor2.GetNodeByName(sec.sensor, dependency.Name)
+			convey.So(node.Phase, convey.ShouldEqual, v1alpha1.NodePhaseComplete)
+			convey.So(sec.sensor.Status.CompletionCount, convey.ShouldEqual, 0)
+
+			sec.sensor.Spec.Suspend = false
+			sec.resumeSensor()
+			convey.So(sec.sensor.Status.CompletionCount, convey.ShouldEqual, 1)
+		})
+
+		convey.Convey("A met deadline must not resolve the dependency", func() {
+			sec.recordPresence(&dependency)
+			sec.completeAbsenceDeadline(dependency.Name, deadline)
+			convey.So(sec.sensor.Status.CompletionCount, convey.ShouldEqual, 0)
+			convey.So(sec.absences[dependency.Name].received, convey.ShouldBeFalse)
+		})
+
+		convey.Convey("An event must extend a duration deadline", func() {
+			dependency.Absence = &v1alpha1.Absence{
+				Duration: "1h",
+			}
+			sec.recordPresence(&dependency)
+			convey.So(sec.absences[dependency.Name], convey.ShouldNotEqual, deadline)
+
+			sec.completeAbsenceDeadline(dependency.Name, deadline)
+			convey.So(sec.sensor.Status.CompletionCount, convey.ShouldEqual, 0)
+		})
+
+		convey.Convey("Removing the absence must disarm the deadline", func() {
+			sec.sensor.Spec.Dependencies[0].Absence = nil
+			sec.armAbsenceDeadlines()
+			convey.So(sec.absences, convey.ShouldNotContainKey, dependency.Name)
+		})
+
+		for _, deadline := range sec.absences {
+			deadline.timer.Stop()
+		}
+	})
+}
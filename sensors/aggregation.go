@@ -0,0 +1,146 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	sn "github.com/argoproj/argo-events/controllers/sensor"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/tidwall/gjson"
+)
+
+// aggregationSample is a value observed for an aggregating event dependency
+type aggregationSample struct {
+	// time at which the value was observed
+	time time.Time
+	// value to aggregate
+	value float64
+}
+
+// aggregateEvent adds the event to the sliding window of the aggregating event dependency and
+// resolves the dependency once the aggregate over the window crosses the threshold
+func (sec *sensorExecutionCtx) aggregateEvent(dependency *v1alpha1.EventDependency, event *apicommon.Event) {
+	aggregation := dependency.Aggregation
+
+	window, err := time.ParseDuration(aggregation.Window)
+	if err != nil {
+		sec.log.Error().Err(err).Str("event-dependency-name", dependency.Name).Msg("failed to parse aggregation window")
+		sn.MarkNodePhase(sec.sensor, dependency.Name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseError, nil, &sec.log, fmt.Sprintf("failed to parse aggregation window. err: %+v", err))
+		return
+	}
+	threshold, err := strconv.ParseFloat(aggregation.Threshold, 64)
+	if err != nil {
+		sec.log.Error().Err(err).Str("event-dependency-name", dependency.Name).Msg("failed to parse aggregation threshold")
+		sn.MarkNodePhase(sec.sensor, dependency.Name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseError, nil, &sec.log, fmt.Sprintf("failed to parse aggregation threshold. err: %+v", err))
+		return
+	}
+
+	value := 1.0
+	if aggregation.Function != v1alpha1.AggregationCount {
+		if value, err = aggregationValue(event, aggregation.Path); err != nil {
+			sec.log.Warn().Err(err).Str("event-dependency-name", dependency.Name).Msg("event does not hold a value to aggregate, ignoring event")
+			return
+		}
+	}
+
+	now := time.Now().UTC()
+	samples := evictSamples(append(sec.aggregations[dependency.Name], aggregationSample{time: now, value: value}), now.Add(-window))
+	result := aggregate(aggregation.Function, samples)
+
+	if !crossesThreshold(aggregation.Operator, result, threshold) {
+		sec.aggregations[dependency.Name] = samples
+		if node := sn.GetNodeByName(sec.sensor, dependency.Name); node != nil {
+			node.Message = fmt.Sprintf("%s over window is %v", aggregation.Function, result)
+			sec.sensor.Status.Nodes[node.ID] = *node
+		}
+		sec.log.Info().Str("event-dependency-name", dependency.Name).Float64("aggregate", result).Msg("aggregate has not crossed the threshold")
+		return
+	}
+
+	// the window starts over once the threshold is crossed
+	delete(sec.aggregations, dependency.Name)
+	sn.MarkNodePhase(sec.sensor, dependency.Name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, event, &sec.log,
+		fmt.Sprintf("%s over window is %v, crossed threshold %s", aggregation.Function, result, aggregation.Threshold))
+
+	// check if all event dependencies are complete and kick-off triggers
+	sec.processTriggers()
+}
+
+// aggregationValue returns the numeric value at the path of the event data
+func aggregationValue(event *apicommon.Event, path string) (float64, error) {
+	js, err := common.RenderEventDataAsJSON(event)
+	if err != nil {
+		return 0, err
+	}
+	res := gjson.GetBytes(js, path)
+	if !res.Exists() {
+		return 0, fmt.Errorf("path '%s' does not exist", path)
+	}
+	if res.Type != gjson.Number {
+		return 0, fmt.Errorf("value at path '%s' is not a number", path)
+	}
+	return res.Float(), nil
+}
+
+// evictSamples removes the samples observed before the cutoff. samples are ordered by time.
+func evictSamples(samples []aggregationSample, cutoff time.Time) []aggregationSample {
+	for i, sample := range samples {
+		if sample.time.After(cutoff) {
+			return samples[i:]
+		}
+	}
+	return nil
+}
+
+// aggregate applies the aggregation function to the samples
+func aggregate(function v1alpha1.AggregationFunction, samples []aggregationSample) float64 {
+	if function == v1alpha1.AggregationCount {
+		return float64(len(samples))
+	}
+	sum := 0.0
+	for _, sample := range samples {
+		sum += sample.value
+	}
+	if function == v1alpha1.AggregationAverage {
+		if len(samples) == 0 {
+			return 0
+		}
+		return sum / float64(len(samples))
+	}
+	return sum
+}
+
+// crossesThreshold compares the value against the threshold using the operator
+func crossesThreshold(operator v1alpha1.ComparisonOperator, value float64, threshold float64) bool {
+	switch operator {
+	case v1alpha1.GreaterThanOrEqualTo:
+		return value >= threshold
+	case v1alpha1.LessThan:
+		return value < threshold
+	case v1alpha1.LessThanOrEqualTo:
+		return value <= threshold
+	case v1alpha1.EqualTo:
+		return value == threshold
+	default:
+		return value > threshold
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"testing"
+	"time"
+
+	sensor2 "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestAggregateEvent(t *testing.T) {
+	convey.Convey("Given a sensor with an aggregating event dependency", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sensor.Spec.Dependencies[0].Aggregation = &v1alpha1.Aggregation{
+			Function:  v1alpha1.AggregationCount,
+			Window:    "1h",
+			Operator:  v1alpha1.GreaterThanOrEqualTo,
+			Threshold: "2",
+		}
+		sec := getsensorExecutionCtx(sensor)
+		sec.sensor, err = sec.sensorClient.ArgoprojV1alpha1().Sensors(sensor.Namespace).Create(sensor)
+		convey.So(err, convey.ShouldBeNil)
+
+		sensor2.InitializeNode(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+		sensor2.MarkNodePhase(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseActive, nil, &sec.log, "node is active")
+		sensor2.InitializeNode(sec.sensor, "test-workflow-trigger", v1alpha1.NodeTypeTrigger, &sec.log, "trigger is init")
+
+		dependency := sec.sensor.Spec.Dependencies[0]
+
+		convey.Convey("The dependency must remain active until the threshold is crossed", func() {
+			sec.aggregateEvent(&dependency, getCloudEvent())
+			node := sensor2.GetNodeByName(sec.sensor, dependency.Name)
+			convey.So(node.Phase, convey.ShouldEqual, v1alpha1.NodePhaseActive)
+			convey.So(len(sec.aggregations[dependency.Name]), convey.ShouldEqual, 1)
+
+			convey.Convey("Crossing the threshold must resolve the dependency and reset the window", func() {
+				sec.aggregateEvent(&dependency, getCloudEvent())
+				convey.So(sec.aggregations, convey.ShouldNotContainKey, dependency.Name)
+				convey.So(sec.sensor.Status.CompletionCount, convey.ShouldEqual, 1)
+			})
+		})
+	})
+}
+
+func Test_aggregate(t *testing.T) {
+	samples := []aggregationSample{{value: 1}, {value: 2}, {value: 6}}
+	tests := []struct {
+		name     string
+		function v1alpha1.AggregationFunction
+		samples  []aggregationSample
+		want     float64
+	}{
+		{"count", v1alpha1.AggregationCount, samples, 3},
+		{"sum", v1alpha1.AggregationSum, samples, 9},
+		{"average", v1alpha1.AggregationAverage, samples, 3},
+		{"average of empty window", v1alpha1.AggregationAverage, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := aggregate(tt.function, tt.samples); got != tt.want {
+				t.Errorf("aggregate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_crossesThreshold(t *testing.T) {
+	tests := []struct {
+		operator v1alpha1.ComparisonOperator
+		value    float64
+		want     bool
+	}{
+		{"", 6, true},
+		{"", 5, false},
+		{v1alpha1.GreaterThan, 5, false},
+		{v1alpha1.GreaterThanOrEqualTo, 5, true},
+		{v1alpha1.LessThan, 4, true},
+		{v1alpha1.LessThanOrEqualTo, 6, false},
+		{v1alpha1.EqualTo, 5, true},
+	}
+	for _, tt := range tests {
+		if got := crossesThreshold(tt.operator, tt.value, 5); got != tt.want {
+			t.Errorf("crossesThreshold(%q, %v, 5) = %v, want %v", tt.operator, tt.value, got, tt.want)
+		}
+	}
+}
+
+func Test_evictSamples(t *testing.T) {
+	now := time.Now()
+	samples := []aggregationSample{
+		{time: now.Add(-2 * time.Hour), value: 1},
+		{time: now.Add(-30 * time.Minute), value: 2},
+		{time: now, value: 3},
+	}
+	got := evictSamples(samples, now.Add(-time.Hour))
+	if len(got) != 2 || got[0].value != 2 {
+		t.Errorf("evictSamples() = %+v", got)
+	}
+	if got := evictSamples(samples, now); len(got) != 0 {
+		t.Errorf("evictSamples() = %+v, want empty window", got)
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	sn "github.com/argoproj/argo-events/controllers/sensor"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// eventBatch is an open batch of events for an event dependency
+type eventBatch struct {
+	// timer completes the batch once the window has elapsed. nil if the batch has no window
+	timer *time.Timer
+}
+
+// addEventToBatch adds the event and the results of its enrichments to the open batch of the event dependency,
+// opening a new batch if there is none.
+func (sec *sensorExecutionCtx) addEventToBatch(dependency *v1alpha1.EventDependency, event *apicommon.Event, enrichment map[string]string) {
+	node := sn.GetNodeByName(sec.sensor, dependency.Name)
+	if node == nil {
+		sec.log.Warn().Str("event-dependency-name", dependency.Name).Msg("event dependency node does not exist, cannot batch event")
+		return
+	}
+
+	if _, ok := sec.batches[dependency.Name]; !ok {
+		now := time.Now().UTC()
+		if !sec.openBatch(dependency, now) {
+			return
+		}
+		// a new batch replaces the previous one. The node starts with the batch, so the window of the batch is known after a restart
+		node.Events = nil
+		node.BatchEnrichments = nil
+		node.Phase = v1alpha1.NodePhaseActive
+		node.StartedAt = metav1.MicroTime{Time: now}
+		sec.log.Info().Str("event-dependency-name", dependency.Name).Msg("opened a new batch of events")
+	}
+
+	node.Events = append(node.Events, *event)
+	if len(dependency.Enrichments) > 0 {
+		node.BatchEnrichments = append(node.BatchEnrichments, v1alpha1.EnrichmentResults{Results: enrichment})
+	}
+	node.Event = event
+	node.Message = fmt.Sprintf("%d events in batch", len(node.Events))
+	sec.sensor.Status.Nodes[node.ID] = *node
+
+	if dependency.Batch.MaxEvents > 0 && len(node.Events) >= int(dependency.Batch.MaxEvents) {
+		sec.completeBatch(dependency.Name)
+	}
+}
+
+// openBatch opens a batch for the event dependency, arming the timer of its window from the time the batch was opened.
+// It returns false if the window can't be parsed.
+func (sec *sensorExecutionCtx) openBatch(dependency *v1alpha1.EventDependency, opened time.Time) bool {
+	batch := &eventBatch{}
+	if dependency.Batch.Window != "" {
+		window, err := time.ParseDuration(dependency.Batch.Window)
+		if err != nil {
+			sec.log.Error().Err(err).Str("event-dependency-name", dependency.Name).Msg("failed to parse batch window")
+			sn.MarkNodePhase(sec.sensor, dependency.Name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseError, nil, &sec.log, fmt.Sprintf("failed to parse batch window. err: %+v", err))
+			return false
+		}
+		dependencyName := dependency.Name
+		batch.timer = time.AfterFunc(time.Until(opened.Add(window)), func() {
+			sec.enqueue(&updateNotification{
+				notificationType: v1alpha1.BatchWindowNotification,
+				eventDependency:  &v1alpha1.EventDependency{Name: dependencyName},
+				batch:            batch,
+			})
+		})
+	}
+	sec.batches[dependency.Name] = batch
+	return true
+}
+
+// restoreBatches reopens the batches the nodes of the batching event dependencies hold after a restart, so the events restored
+// in the nodes are kept in the batch and the windows elapse when they would have without the restart.
+// It must be called once the events of the nodes are restored from the state store.
+func (sec *sensorExecutionCtx) restoreBatches() {
+	for i := range sec.sensor.Spec.Dependencies {
+		dependency := &sec.sensor.Spec.Dependencies[i]
+		if dependency.Batch == nil {
+			continue
+		}
+		if _, ok := sec.batches[dependency.Name]; ok {
+			continue
+		}
+		node := sn.GetNodeByName(sec.sensor, dependency.Name)
+		if node == nil || node.Phase != v1alpha1.NodePhaseActive || len(node.Events) == 0 {
+			continue
+		}
+		if sec.openBatch(dependency, node.StartedAt.Time) {
+			sec.log.Info().Str("event-dependency-name", dependency.Name).Int("events", len(node.Events)).Msg("restored open batch of events")
+		}
+	}
+}
+
+// completeBatch closes the open batch of the event dependency, resolves the dependency and processes triggers
+func (sec *sensorExecutionCtx) completeBatch(dependencyName string) {
+	batch, ok := sec.batches[dependencyName]
+	if !ok {
+		return
+	}
+	if batch.timer != nil {
+		batch.timer.Stop()
+	}
+	delete(sec.batches, dependencyName)
+
+	node := sn.GetNodeByName(sec.sensor, dependencyName)
+	if node == nil || len(node.Events) == 0 {
+		return
+	}
+	sn.MarkNodePhase(sec.sensor, dependencyName, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, nil, &sec.log, fmt.Sprintf("batch of %d events is complete", len(node.Events)))
+
+	// check if all event dependencies are complete and kick-off triggers
+	sec.processTriggers()
+}
+
+// completeBatchWindow completes the batch whose window has elapsed, unless the batch has already been completed
+func (sec *sensorExecutionCtx) completeBatchWindow(dependencyName string, batch *eventBatch) {
+	if current, ok := sec.batches[dependencyName]; !ok || current != batch {
+		sec.log.Info().Str("event-dependency-name", dependencyName).Msg("batch is already complete")
+		return
+	}
+	sec.completeBatch(dependencyName)
+}
+
+// renderBatchAsEvent renders a batch of events as a single event whose payload is a JSON array of the event payloads
+func renderBatchAsEvent(events []apicommon.Event) (*apicommon.Event, error) {
+	if len(events) == 0 {
+		return nil, fmt.Errorf("batch is empty")
+	}
+	var buf bytes.Buffer
+	buf.WriteString("[")
+	for i := range events {
+		js, err := common.RenderEventDataAsJSON(&events[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to render event %s of the batch as JSON. err: %+v", events[i].Context.EventID, err)
+		}
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.Write(js)
+	}
+	buf.WriteString("]")
+
+	last := events[len(events)-1]
+	batchEvent := &apicommon.Event{
+		Context: *last.Context.DeepCopy(),
+		Payload: buf.Bytes(),
+	}
+	batchEvent.Context.ContentType = common.MediaTypeJSON
+	return batchEvent, nil
+}
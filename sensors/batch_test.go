@@ -0,0 +1,151 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	sensor2 "github.com/argoproj/argo-events/controllers/sensor"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEventBatch(t *testing.T) {
+	convey.Convey("Given a sensor with a batching event dependency", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sensor.Spec.Dependencies[0].Batch = &v1alpha1.Batch{
+			Window:    "1h",
+			MaxEvents: 2,
+		}
+		sec := getsensorExecutionCtx(sensor)
+		sec.sensor, err = sec.sensorClient.ArgoprojV1alpha1().Sensors(sensor.Namespace).Create(sensor)
+		convey.So(err, convey.ShouldBeNil)
+
+		sensor2.InitializeNode(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+		sensor2.MarkNodePhase(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseActive, nil, &sec.log, "node is active")
+		sensor2.InitializeNode(sec.sensor, "test-workflow-trigger", v1alpha1.NodeTypeTrigger, &sec.log, "trigger is init")
+
+		dependency := sec.sensor.Spec.Dependencies[0]
+
+		convey.Convey("The dependency must remain active until the batch is complete", func() {
+			sec.addEventToBatch(&dependency, getCloudEvent(), nil)
+			node := sensor2.GetNodeByName(sec.sensor, dependency.Name)
+			convey.So(node.Phase, convey.ShouldEqual, v1alpha1.NodePhaseActive)
+			convey.So(len(node.Events), convey.ShouldEqual, 1)
+			convey.So(sec.batches, convey.ShouldContainKey, dependency.Name)
+
+			convey.Convey("Reaching max events must complete the batch and execute triggers", func() {
+				sec.addEventToBatch(&dependency, getCloudEvent(), nil)
+				node := sensor2.GetNodeByName(sec.sensor, dependency.Name)
+				convey.So(len(node.Events), convey.ShouldEqual, 2)
+				convey.So(sec.batches, convey.ShouldNotContainKey, dependency.Name)
+				convey.So(sec.sensor.Status.CompletionCount, convey.ShouldEqual, 1)
+			})
+
+			convey.Convey("An elapsed window of a suspended sensor must complete the batch without executing triggers", func() {
+				sec.sensor.Spec.Suspend = true
+				sec.completeBatchWindow(dependency.Name, sec.batches[dependency.Name])
+				node := sensor2.GetNodeByName(sec.sensor, dependency.Name)
+				convey.So(node.Phase, convey.ShouldEqual, v1alpha1.NodePhaseComplete)
+				convey.So(sec.batches, convey.ShouldNotContainKey, dependency.Name)
+				convey.So(sec.sensor.Status.CompletionCount, convey.ShouldEqual, 0)
+
+				sec.sensor.Spec.Suspend = false
+				sec.resumeSensor()
+				convey.So(sec.sensor.Status.CompletionCount, convey.ShouldEqual, 1)
+			})
+
+			convey.Convey("The open batch must be restored after a restart", func() {
+				restarted := getsensorExecutionCtx(sec.sensor.DeepCopy())
+				restarted.sensorClient = sec.sensorClient
+				restarted.kubeClient = sec.kubeClient
+				restarted.clientPool = sec.clientPool
+				restarted.restoreBatches()
+				convey.So(restarted.batches, convey.ShouldContainKey, dependency.Name)
+
+				restarted.addEventToBatch(&dependency, getCloudEvent(), nil)
+				node := sensor2.GetNodeByName(restarted.sensor, dependency.Name)
+				convey.So(len(node.Events), convey.ShouldEqual, 2)
+				convey.So(restarted.sensor.Status.CompletionCount, convey.ShouldEqual, 1)
+			})
+
+			convey.Convey("The window of a restored batch must elapse from the time the batch was opened", func() {
+				node := sensor2.GetNodeByName(sec.sensor, dependency.Name)
+				node.StartedAt = metav1.MicroTime{Time: time.Now().Add(-2 * time.Hour)}
+				sec.sensor.Status.Nodes[node.ID] = *node
+
+				restarted := getsensorExecutionCtx(sec.sensor.DeepCopy())
+				restarted.queue = make(chan *updateNotification, 1)
+				restarted.restoreBatches()
+				select {
+				case notification := <-restarted.queue:
+					convey.So(notification.notificationType, convey.ShouldEqual, v1alpha1.BatchWindowNotification)
+					convey.So(notification.batch, convey.ShouldEqual, restarted.batches[dependency.Name])
+				case <-time.After(time.Second):
+					convey.So("batch window did not elapse", convey.ShouldBeEmpty)
+				}
+			})
+
+			convey.Convey("An elapsed window of a stale batch must be ignored", func() {
+				sec.completeBatchWindow(dependency.Name, &eventBatch{})
+				convey.So(sec.batches, convey.ShouldContainKey, dependency.Name)
+
+				sec.completeBatchWindow(dependency.Name, sec.batches[dependency.Name])
+				convey.So(sec.batches, convey.ShouldNotContainKey, dependency.Name)
+				convey.So(sec.sensor.Status.CompletionCount, convey.ShouldEqual, 1)
+			})
+		})
+	})
+}
+
+func Test_renderBatchAsEvent(t *testing.T) {
+	events := []apicommon.Event{
+		{
+			Context: apicommon.EventContext{
+				ContentType: common.MediaTypeJSON,
+				EventID:     "1",
+			},
+			Payload: []byte(`{"key":"a"}`),
+		},
+		{
+			Context: apicommon.EventContext{
+				ContentType: common.MediaTypeYAML,
+				EventID:     "2",
+			},
+			Payload: []byte(`key: b`),
+		},
+	}
+	event, err := renderBatchAsEvent(events)
+	if err != nil {
+		t.Fatalf("renderBatchAsEvent() error = %v", err)
+	}
+	if string(event.Payload) != `[{"key":"a"},{"key":"b"}]` {
+		t.Errorf("renderBatchAsEvent() payload = %s", event.Payload)
+	}
+	if event.Context.EventID != "2" || event.Context.ContentType != common.MediaTypeJSON {
+		t.Errorf("renderBatchAsEvent() context = %+v", event.Context)
+	}
+
+	if _, err := renderBatchAsEvent(nil); err == nil {
+		t.Errorf("renderBatchAsEvent() expected error for empty batch")
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"os"
+	"strconv"
 )
 
 func main() {
@@ -46,6 +47,12 @@ func main() {
 	if !ok {
 		panic("sensor controller instance ID is not provided")
 	}
+	replica := 0
+	if value, ok := os.LookupEnv(common.EnvVarSensorReplica); ok {
+		if replica, err = strconv.Atoi(value); err != nil {
+			panic(fmt.Errorf("failed to parse sensor replica. err: %+v", err))
+		}
+	}
 
 	sensorClient, err := sv1.NewForConfig(restConfig)
 	if err != nil {
@@ -61,6 +68,6 @@ func main() {
 	disco := discovery.NewDiscoveryClientForConfigOrDie(restConfig)
 
 	// wait for sensor http server to shutdown
-	sensorExecutionCtx := sc.NewSensorExecutionCtx(sensorClient, kubeClient, clientPool, disco, sensor, controllerInstanceID)
+	sensorExecutionCtx := sc.NewSensorExecutionCtx(sensorClient, kubeClient, clientPool, disco, sensor, controllerInstanceID, replica)
 	sensorExecutionCtx.WatchEventsFromGateways()
 }
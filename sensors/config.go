@@ -2,6 +2,8 @@ package sensors
 
 import (
 	"net/http"
+	"sync/atomic"
+	"time"
 
 	"github.com/nats-io/go-nats"
 
@@ -28,7 +30,7 @@ type sensorExecutionCtx struct {
 	discoveryClient discovery.DiscoveryInterface
 	// sensor object
 	sensor *v1alpha1.Sensor
-	// http server which exposes the sensor to gateway/s
+	// http server which exposes the sensor to gateway/s, or the admin server of sensors that receive events over NATS
 	server *http.Server
 	// logger for the sensor
 	log zerolog.Logger
@@ -40,6 +42,48 @@ type sensorExecutionCtx struct {
 	updated bool
 	// nconn is the nats connection
 	nconn natsconn
+	// suspendedEvents holds the events received while the sensor is suspended
+	suspendedEvents []*updateNotification
+	// triggerLimiters holds the rate limiters for triggers, keyed by trigger name
+	triggerLimiters map[string]*triggerLimiter
+	// debounceTimers holds the pending debounced trigger executions, keyed by trigger name
+	debounceTimers map[string]*time.Timer
+	// batches holds the open batches of events, keyed by event dependency name
+	batches map[string]*eventBatch
+	// aggregations holds the sliding windows of aggregating event dependencies, keyed by event dependency name
+	aggregations map[string][]aggregationSample
+	// absences holds the pending deadlines of absence event dependencies, keyed by event dependency name
+	absences map[string]*absenceDeadline
+	// deadLetterConn is the nats connection of the dead letter sink
+	deadLetterConn *nats.Conn
+	// store holds the events of the event dependency nodes outside of the sensor resource. nil if the sensor has no state store
+	store stateStore
+	// storedEvents holds the events last put in the state store, keyed by state store key
+	storedEvents map[string]*nodeEvents
+	// replica is the index of this replica of a scaled out sensor
+	replica int
+	// leader is 1 if this replica holds the leader lease of a scaled out sensor. accessed atomically
+	leader int32
+	// partition holds the state of the partition of this replica of a scaled out sensor last persisted in its partition configmap
+	partition string
+	// backlog is the number of update notifications sent over the queue and not processed yet. accessed atomically
+	backlog int64
+	// done is closed once the sensor is shut down and its connections are closed
+	done chan struct{}
+	// shuttingDown is 1 once the sensor stopped the intake of events and is draining its queue. accessed atomically
+	shuttingDown int32
+	// processingSince is the time in unix nanoseconds the queue started processing the current notification, 0 if idle. accessed atomically
+	processingSince int64
+	// subscriptions is the number of nats subscriptions to the event dependencies. accessed atomically
+	subscriptions int32
+	// dependencies is the number of event dependencies to subscribe to over nats. accessed atomically
+	dependencies int32
+	// triggerHistory holds the latest trigger executions
+	triggerHistory []triggerExecution
+	// state holds the latest *sensorState snapshot exposed on the debug endpoint
+	state atomic.Value
+	// spec holds the latest *v1alpha1.SensorSpec snapshot read by the handlers running outside of the queue
+	spec atomic.Value
 }
 
 type natsconn struct {
@@ -47,6 +91,8 @@ type natsconn struct {
 	standard *nats.Conn
 	// streaming connection
 	stream snats.Conn
+	// unsubscribers stop the subscriptions to the event dependencies
+	unsubscribers []func() error
 }
 
 // updateNotification is servers as a notification message that can be used to update event dependency's state or the sensor resource
@@ -56,20 +102,36 @@ type updateNotification struct {
 	writer           http.ResponseWriter
 	sensor           *v1alpha1.Sensor
 	notificationType v1alpha1.NotificationType
+	// trigger is the name of the trigger to execute for a debounced trigger notification
+	trigger string
+	// batch is the batch whose window has elapsed for a batch window notification
+	batch *eventBatch
+	// absence is the deadline that has passed for an absence deadline notification
+	absence *absenceDeadline
+	// processed receives the outcome of the processing of an event notification, once the state of the sensor is persisted
+	processed chan error
 }
 
 // NewSensorExecutionCtx returns a new sensor execution context.
 func NewSensorExecutionCtx(sensorClient clientset.Interface, kubeClient kubernetes.Interface,
 	clientPool dynamic.ClientPool, discoveryClient discovery.DiscoveryInterface,
-	sensor *v1alpha1.Sensor, controllerInstanceID string) *sensorExecutionCtx {
+	sensor *v1alpha1.Sensor, controllerInstanceID string, replica int) *sensorExecutionCtx {
 	return &sensorExecutionCtx{
 		sensorClient:         sensorClient,
 		kubeClient:           kubeClient,
 		clientPool:           clientPool,
 		discoveryClient:      discoveryClient,
 		sensor:               sensor,
-		log:                  common.GetLoggerContext(common.LoggerConf()).Str("sensor-name", sensor.Name).Logger(),
+		log:                  common.GetLoggerContext(common.LoggerConf()).Str("sensor-name", sensor.Name).Int("replica", replica).Logger(),
 		queue:                make(chan *updateNotification),
 		controllerInstanceID: controllerInstanceID,
+		triggerLimiters:      make(map[string]*triggerLimiter),
+		debounceTimers:       make(map[string]*time.Timer),
+		batches:              make(map[string]*eventBatch),
+		aggregations:         make(map[string][]aggregationSample),
+		absences:             make(map[string]*absenceDeadline),
+		storedEvents:         make(map[string]*nodeEvents),
+		replica:              replica,
+		done:                 make(chan struct{}),
 	}
 }
@@ -0,0 +1,255 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	sn "github.com/argoproj/argo-events/controllers/sensor"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/nats-io/go-nats"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// deadLetterHTTPClient is the client used by the HTTP dead letter sink
+var deadLetterHTTPClient = &http.Client{
+	Timeout: 10 * time.Second,
+}
+
+// deadLetterConfigMapMaxSize is the maximum size of the dead letters kept in a configmap, below the 1MiB limit of a configmap
+const deadLetterConfigMapMaxSize = 1000 * 1024
+
+// invalidConfigMapKeyChars matches the characters that are not allowed in the key of a configmap
+var invalidConfigMapKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+
+// deadLetter is an event that is rejected by filters or failed to trigger, along with the reason of the failure.
+// The event can be replayed by sending it to the sensor again.
+type deadLetter struct {
+	// Event is the rejected event
+	Event apicommon.Event `json:"event"`
+	// Reason of the failure
+	Reason string `json:"reason"`
+	// Trigger is the name of the failed trigger, if any
+	Trigger string `json:"trigger,omitempty"`
+	// Time of the failure
+	Time metav1.Time `json:"time"`
+}
+
+// sendToDeadLetter sends the event to the dead letter sink of the sensor, if any
+func (sec *sensorExecutionCtx) sendToDeadLetter(event *apicommon.Event, reason string, trigger string) {
+	sink := sec.sensor.Spec.DeadLetter
+	if sink == nil || event == nil {
+		return
+	}
+	letter := &deadLetter{
+		Event:   *event,
+		Reason:  reason,
+		Trigger: trigger,
+		Time:    metav1.Time{Time: time.Now().UTC()},
+	}
+	payload, err := json.Marshal(letter)
+	if err != nil {
+		sec.log.Error().Err(err).Str("event-id", event.Context.EventID).Msg("failed to marshal dead letter")
+		return
+	}
+
+	switch {
+	case sink.Nats != nil:
+		err = sec.publishDeadLetter(sink.Nats, payload)
+	case sink.Http != nil:
+		err = postDeadLetter(sink.Http, payload)
+	case sink.ConfigMap != nil:
+		err = sec.storeDeadLetter(sink.ConfigMap, letter, payload)
+	case sink.File != nil:
+		err = appendDeadLetter(sink.File, payload)
+	default:
+		err = fmt.Errorf("dead letter sink does not define a destination")
+	}
+	if err != nil {
+		sec.log.Error().Err(err).Str("event-id", event.Context.EventID).Str("reason", reason).Msg("failed to send event to dead letter sink")
+		return
+	}
+	sec.log.Info().Str("event-id", event.Context.EventID).Str("reason", reason).Msg("sent event to dead letter sink")
+}
+
+// sendTriggerEventsToDeadLetter sends the events of the event dependencies to the dead letter sink on failure of the trigger.
+// Every event of the batch of a batching event dependency is sent.
+func (sec *sensorExecutionCtx) sendTriggerEventsToDeadLetter(trigger string, reason string) {
+	if sec.sensor.Spec.DeadLetter == nil {
+		return
+	}
+	for _, dependency := range sec.sensor.Spec.Dependencies {
+		node := sn.GetNodeByName(sec.sensor, dependency.Name)
+		if node == nil {
+			continue
+		}
+		if len(node.Events) > 0 {
+			for i := range node.Events {
+				sec.sendToDeadLetter(&node.Events[i], reason, trigger)
+			}
+			continue
+		}
+		if node.Event != nil {
+			sec.sendToDeadLetter(node.Event, reason, trigger)
+		}
+	}
+}
+
+// publishDeadLetter publishes the dead letter on the nats subject
+func (sec *sensorExecutionCtx) publishDeadLetter(sink *v1alpha1.NatsSink, payload []byte) error {
+	if sec.deadLetterConn == nil || sec.deadLetterConn.IsClosed() {
+		conn, err := nats.Connect(sink.URL)
+		if err != nil {
+			return err
+		}
+		sec.deadLetterConn = conn
+	}
+	return sec.deadLetterConn.Publish(sink.Subject, payload)
+}
+
+// postDeadLetter posts the dead letter to the HTTP endpoint
+func postDeadLetter(sink *v1alpha1.HttpSink, payload []byte) error {
+	resp, err := deadLetterHTTPClient.Post(sink.URL, common.MediaTypeJSON, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	ioutil.ReadAll(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("dead letter endpoint responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// storeDeadLetter stores the dead letter in the configmap, removing the oldest entries beyond the maximum number of entries
+// or the maximum size of a configmap
+func (sec *sensorExecutionCtx) storeDeadLetter(sink *v1alpha1.ConfigMapSink, letter *deadLetter, payload []byte) error {
+	maxEntries := common.DefaultDeadLetterMaxEntries
+	if sink.MaxEntries > 0 {
+		maxEntries = int(sink.MaxEntries)
+	}
+	key := deadLetterKey(letter)
+	if len(key)+len(payload) > deadLetterConfigMapMaxSize {
+		return fmt.Errorf("dead letter is %d bytes, more than the %d bytes a configmap can hold", len(payload), deadLetterConfigMapMaxSize)
+	}
+
+	configmaps := sec.kubeClient.CoreV1().ConfigMaps(sec.sensor.Namespace)
+	cm, err := configmaps.Get(sink.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return err
+		}
+		_, err = configmaps.Create(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      sink.Name,
+				Namespace: sec.sensor.Namespace,
+				Labels: map[string]string{
+					common.LabelSensorName: sec.sensor.Name,
+				},
+			},
+			Data: map[string]string{
+				key: string(payload),
+			},
+		})
+		return err
+	}
+
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[key] = string(payload)
+	keys := make([]string, 0, len(cm.Data))
+	size := 0
+	for k, v := range cm.Data {
+		keys = append(keys, k)
+		size += len(k) + len(v)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if len(cm.Data) <= maxEntries && size <= deadLetterConfigMapMaxSize {
+			break
+		}
+		size -= len(k) + len(cm.Data[k])
+		delete(cm.Data, k)
+	}
+	_, err = configmaps.Update(cm)
+	return err
+}
+
+// deadLetterKey returns the key of the dead letter in a configmap. Keys are ordered by the time of the failure,
+// and the characters of the event ID that are not allowed in a configmap key are replaced.
+func deadLetterKey(letter *deadLetter) string {
+	key := fmt.Sprintf("%d-%s", letter.Time.UnixNano(), invalidConfigMapKeyChars.ReplaceAllString(letter.Event.Context.EventID, "_"))
+	if len(key) > validation.DNS1123SubdomainMaxLength {
+		key = key[:validation.DNS1123SubdomainMaxLength]
+	}
+	return key
+}
+
+// appendDeadLetter appends the dead letter to the file, removing the oldest entries beyond the maximum
+func appendDeadLetter(sink *v1alpha1.FileSink, payload []byte) error {
+	maxEntries := common.DefaultDeadLetterMaxEntries
+	if sink.MaxEntries > 0 {
+		maxEntries = int(sink.MaxEntries)
+	}
+
+	var lines [][]byte
+	if f, err := os.Open(sink.Path); err == nil {
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+		for scanner.Scan() {
+			lines = append(lines, append([]byte(nil), scanner.Bytes()...))
+		}
+		f.Close()
+		if err := scanner.Err(); err != nil {
+			return err
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	lines = append(lines, payload)
+	if len(lines) > maxEntries {
+		lines = lines[len(lines)-maxEntries:]
+	}
+
+	var buf bytes.Buffer
+	for _, line := range lines {
+		buf.Write(line)
+		buf.WriteString("\n")
+	}
+	// write to a temporary file first so that a crash does not truncate the dead letters
+	tmp := sink.Path + ".tmp"
+	if err := ioutil.WriteFile(tmp, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, sink.Path)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sensor2 "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeadLetter(t *testing.T) {
+	convey.Convey("Given a sensor with a dead letter sink", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sec := getsensorExecutionCtx(sensor)
+
+		convey.Convey("Dead letters must be capped in the configmap", func() {
+			sec.sensor.Spec.DeadLetter = &v1alpha1.DeadLetterSink{
+				ConfigMap: &v1alpha1.ConfigMapSink{
+					Name:       "dead-letters",
+					MaxEntries: 2,
+				},
+			}
+			for i := 0; i < 3; i++ {
+				sec.sendToDeadLetter(getCloudEvent(), "event did not pass filters", "")
+			}
+			cm, err := sec.kubeClient.CoreV1().ConfigMaps(sensor.Namespace).Get("dead-letters", metav1.GetOptions{})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(cm.Data), convey.ShouldEqual, 2)
+			for _, value := range cm.Data {
+				var letter deadLetter
+				convey.So(json.Unmarshal([]byte(value), &letter), convey.ShouldBeNil)
+				convey.So(letter.Reason, convey.ShouldEqual, "event did not pass filters")
+				convey.So(letter.Event.Context.EventType, convey.ShouldEqual, "test")
+			}
+		})
+
+		convey.Convey("Dead letters must be keyed by a valid configmap key and capped to the size of a configmap", func() {
+			sec.sensor.Spec.DeadLetter = &v1alpha1.DeadLetterSink{
+				ConfigMap: &v1alpha1.ConfigMapSink{
+					Name:       "dead-letters",
+					MaxEntries: 100,
+				},
+			}
+			event := getCloudEvent()
+			event.Context.EventID = "urn:event/1 2"
+			event.Payload = []byte(strings.Repeat("a", deadLetterConfigMapMaxSize/4))
+			for i := 0; i < 5; i++ {
+				sec.sendToDeadLetter(event, "event did not pass filters", "")
+			}
+			cm, err := sec.kubeClient.CoreV1().ConfigMaps(sensor.Namespace).Get("dead-letters", metav1.GetOptions{})
+			convey.So(err, convey.ShouldBeNil)
+			size := 0
+			for key, value := range cm.Data {
+				convey.So(key, convey.ShouldEndWith, "-urn_event_1_2")
+				size += len(key) + len(value)
+			}
+			convey.So(len(cm.Data), convey.ShouldBeBetween, 0, 4)
+			convey.So(size, convey.ShouldBeLessThanOrEqualTo, deadLetterConfigMapMaxSize)
+
+			event.Payload = []byte(strings.Repeat("a", deadLetterConfigMapMaxSize))
+			sec.sendToDeadLetter(event, "event did not pass filters", "")
+			updated, err := sec.kubeClient.CoreV1().ConfigMaps(sensor.Namespace).Get("dead-letters", metav1.GetOptions{})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(updated.Data, convey.ShouldResemble, cm.Data)
+		})
+
+		convey.Convey("Dead letters must be capped in the file", func() {
+			dir, err := ioutil.TempDir("", "dead-letters")
+			convey.So(err, convey.ShouldBeNil)
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "dead-letters.json")
+
+			sec.sensor.Spec.DeadLetter = &v1alpha1.DeadLetterSink{
+				File: &v1alpha1.FileSink{
+					Path:       path,
+					MaxEntries: 2,
+				},
+			}
+			for i := 0; i < 3; i++ {
+				sec.sendToDeadLetter(getCloudEvent(), "failed to execute trigger", "test-workflow-trigger")
+			}
+			content, err := ioutil.ReadFile(path)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(strings.Split(strings.TrimSpace(string(content)), "\n")), convey.ShouldEqual, 2)
+		})
+
+		convey.Convey("Every event of a batch must be sent to the dead letter sink on failure of the trigger", func() {
+			dir, err := ioutil.TempDir("", "dead-letters")
+			convey.So(err, convey.ShouldBeNil)
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "dead-letters.json")
+
+			sec.sensor.Spec.DeadLetter = &v1alpha1.DeadLetterSink{
+				File: &v1alpha1.FileSink{
+					Path: path,
+				},
+			}
+			dependency := sec.sensor.Spec.Dependencies[0]
+			dependency.Batch = &v1alpha1.Batch{
+				MaxEvents: 5,
+			}
+			sensor2.InitializeNode(sec.sensor, dependency.Name, v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+			for i := 0; i < 3; i++ {
+				sec.addEventToBatch(&dependency, getCloudEvent(), nil)
+			}
+			sec.sendTriggerEventsToDeadLetter("test-workflow-trigger", "failed to execute trigger")
+			content, err := ioutil.ReadFile(path)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(strings.Split(strings.TrimSpace(string(content)), "\n")), convey.ShouldEqual, 3)
+		})
+
+		convey.Convey("Dead letters must be posted to the endpoint", func() {
+			var letter deadLetter
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				json.Unmarshal(body, &letter)
+			}))
+			defer server.Close()
+
+			sec.sensor.Spec.DeadLetter = &v1alpha1.DeadLetterSink{
+				Http: &v1alpha1.HttpSink{
+					URL: server.URL,
+				},
+			}
+			sec.sendToDeadLetter(getCloudEvent(), "failed to execute trigger", "test-workflow-trigger")
+			convey.So(letter.Trigger, convey.ShouldEqual, "test-workflow-trigger")
+		})
+	})
+}
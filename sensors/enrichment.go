@@ -0,0 +1,248 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	sn "github.com/argoproj/argo-events/controllers/sensor"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/tidwall/sjson"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// enrichmentHTTPClient is the client used by HTTP lookups
+var enrichmentHTTPClient = &http.Client{
+	Timeout: 10 * time.Second,
+}
+
+// enrichEvent performs the enrichments of the event dependency for the event and returns their JSON encoded results keyed by dest.
+// The event itself is left unchanged.
+func (sec *sensorExecutionCtx) enrichEvent(dependency *v1alpha1.EventDependency, event *apicommon.Event) (map[string]string, error) {
+	results := make(map[string]string, len(dependency.Enrichments))
+	for _, enrichment := range dependency.Enrichments {
+		if len(enrichment.Parameters) > 0 {
+			// parameters refer to the event being enriched or to the events of other dependencies
+			events := sec.extractEvents(enrichment.Parameters)
+			events[dependency.Name] = *event
+			resolved, err := resolveEnrichment(enrichment, events)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve parameters of enrichment %s. err: %+v", enrichment.Name, err)
+			}
+			enrichment = *resolved
+		}
+
+		result, err := sec.lookup(&enrichment)
+		if err != nil {
+			return nil, fmt.Errorf("enrichment %s failed. err: %+v", enrichment.Name, err)
+		}
+
+		dest := enrichment.Dest
+		if dest == "" {
+			dest = enrichment.Name
+		}
+		results[dest] = string(result)
+		sec.log.Info().Str("event-dependency-name", dependency.Name).Str("enrichment", enrichment.Name).Msg("enriched event")
+	}
+	return results, nil
+}
+
+// recordEnrichment stores the results of the enrichments of the last seen event in the event dependency node
+func (sec *sensorExecutionCtx) recordEnrichment(dependencyName string, enrichment map[string]string) {
+	node := sn.GetNodeByName(sec.sensor, dependencyName)
+	if node == nil {
+		return
+	}
+	node.Enrichment = enrichment
+	sec.sensor.Status.Nodes[node.ID] = *node
+}
+
+// mergeEnrichment returns a copy of the event whose data holds the results of the enrichments at their dest.
+// The merged event carries JSON data.
+func mergeEnrichment(event *apicommon.Event, enrichment map[string]string) (*apicommon.Event, error) {
+	js, err := common.RenderEventDataAsJSON(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render event payload as JSON. err: %+v", err)
+	}
+	dests := make([]string, 0, len(enrichment))
+	for dest := range enrichment {
+		dests = append(dests, dest)
+	}
+	// results of nested dests are merged after the results of their parents
+	sort.Strings(dests)
+	for _, dest := range dests {
+		if js, err = sjson.SetRawBytes(js, dest, []byte(enrichment[dest])); err != nil {
+			return nil, fmt.Errorf("failed to merge result of enrichment at %s. err: %+v", dest, err)
+		}
+	}
+	merged := &apicommon.Event{
+		Context: *event.Context.DeepCopy(),
+		Payload: js,
+	}
+	merged.Context.ContentType = common.MediaTypeJSON
+	return merged, nil
+}
+
+// mergeBatchEnrichments returns the events of the batch of the node, each holding the results of its enrichments
+func mergeBatchEnrichments(node *v1alpha1.NodeStatus) ([]apicommon.Event, error) {
+	if len(node.BatchEnrichments) != len(node.Events) {
+		return node.Events, nil
+	}
+	events := make([]apicommon.Event, len(node.Events))
+	for i := range node.Events {
+		if len(node.BatchEnrichments[i].Results) == 0 {
+			events[i] = node.Events[i]
+			continue
+		}
+		merged, err := mergeEnrichment(&node.Events[i], node.BatchEnrichments[i].Results)
+		if err != nil {
+			return nil, err
+		}
+		events[i] = *merged
+	}
+	return events, nil
+}
+
+// resolveEnrichment applies the parameters of the enrichment to the enrichment itself
+func resolveEnrichment(enrichment v1alpha1.Enrichment, events map[string]apicommon.Event) (*v1alpha1.Enrichment, error) {
+	js, err := json.Marshal(enrichment)
+	if err != nil {
+		return nil, err
+	}
+	js, err = applyParams(js, enrichment.Parameters, events)
+	if err != nil {
+		return nil, err
+	}
+	var resolved *v1alpha1.Enrichment
+	if err := json.Unmarshal(js, &resolved); err != nil {
+		return nil, err
+	}
+	return resolved, nil
+}
+
+// lookup performs the lookup of the enrichment and returns the JSON encoded result
+func (sec *sensorExecutionCtx) lookup(enrichment *v1alpha1.Enrichment) ([]byte, error) {
+	switch {
+	case enrichment.Resource != nil:
+		return sec.lookupResource(enrichment.Resource)
+	case enrichment.HTTP != nil:
+		return lookupHTTP(enrichment.HTTP)
+	case enrichment.ConfigMap != nil:
+		return sec.lookupConfigMap(enrichment.ConfigMap)
+	default:
+		return nil, fmt.Errorf("enrichment does not define a lookup")
+	}
+}
+
+// lookupResource gets the K8s object using the dynamic client
+func (sec *sensorExecutionCtx) lookupResource(lookup *v1alpha1.ResourceLookup) ([]byte, error) {
+	namespace := lookup.Namespace
+	if namespace == "" {
+		namespace = sec.sensor.Namespace
+	}
+	gvk := schema.GroupVersionKind{
+		Group:   lookup.Group,
+		Version: lookup.Version,
+		Kind:    lookup.Kind,
+	}
+	client, err := sec.clientPool.ClientForGroupVersionKind(gvk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client for given group verison and kind. err: %+v", err)
+	}
+	apiResource, err := common.ServerResourceForGroupVersionKind(sec.discoveryClient, gvk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server resource for given group verison and kind. err: %+v", err)
+	}
+	obj, err := client.Resource(apiResource, namespace).Get(lookup.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return obj.MarshalJSON()
+}
+
+// lookupHTTP sends a GET request and returns the response body.
+// A body that is not JSON is returned as a JSON string.
+func lookupHTTP(lookup *v1alpha1.HTTPLookup) ([]byte, error) {
+	u, err := url.Parse(lookup.URL)
+	if err != nil {
+		return nil, err
+	}
+	if len(lookup.Query) > 0 {
+		query := u.Query()
+		for key, value := range lookup.Query {
+			query.Set(key, value)
+		}
+		u.RawQuery = query.Encode()
+	}
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range lookup.Headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := enrichmentHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("lookup responded with status %d", resp.StatusCode)
+	}
+	return asJSON(body)
+}
+
+// lookupConfigMap reads the data of the configmap. The value of a key that is not JSON is returned as a JSON string.
+func (sec *sensorExecutionCtx) lookupConfigMap(lookup *v1alpha1.ConfigMapLookup) ([]byte, error) {
+	namespace := lookup.Namespace
+	if namespace == "" {
+		namespace = sec.sensor.Namespace
+	}
+	cm, err := sec.kubeClient.CoreV1().ConfigMaps(namespace).Get(lookup.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if lookup.Key == "" {
+		return json.Marshal(cm.Data)
+	}
+	value, ok := cm.Data[lookup.Key]
+	if !ok {
+		return nil, fmt.Errorf("configmap %s does not have key %s", lookup.Name, lookup.Key)
+	}
+	return asJSON([]byte(value))
+}
+
+// asJSON returns the data if it is JSON, otherwise the data encoded as a JSON string
+func asJSON(data []byte) ([]byte, error) {
+	if json.Valid(data) {
+		return data, nil
+	}
+	return json.Marshal(string(data))
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/argoproj/argo-events/common"
+	sensor2 "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/tidwall/gjson"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEnrichEvent(t *testing.T) {
+	convey.Convey("Given a sensor with an enriched event dependency", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sec := getsensorExecutionCtx(sensor)
+
+		_, err = sec.kubeClient.CoreV1().ConfigMaps(sensor.Namespace).Create(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "owners",
+				Namespace: sensor.Namespace,
+			},
+			Data: map[string]string{
+				"team":    "payments",
+				"contact": `{"slack":"#payments"}`,
+			},
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("id") != "123" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(`{"status":"approved"}`))
+		}))
+		defer server.Close()
+
+		event := getCloudEvent()
+		event.Payload = []byte(`{"id":"123"}`)
+		dependency := sensor.Spec.Dependencies[0]
+
+		convey.Convey("Results of the lookups must be kept apart from the event and merged into its data for trigger parameters", func() {
+			dependency.Enrichments = []v1alpha1.Enrichment{
+				{
+					Name: "team",
+					Dest: "owner.team",
+					ConfigMap: &v1alpha1.ConfigMapLookup{
+						Name: "owners",
+						Key:  "team",
+					},
+				},
+				{
+					Name: "contact",
+					ConfigMap: &v1alpha1.ConfigMapLookup{
+						Name: "owners",
+						Key:  "contact",
+					},
+				},
+				{
+					Name: "review",
+					HTTP: &v1alpha1.HTTPLookup{
+						URL: server.URL,
+					},
+					Parameters: []v1alpha1.ResourceParameter{
+						{
+							Src: &v1alpha1.ResourceParameterSource{
+								Event: dependency.Name,
+								Path:  "id",
+							},
+							Dest: "http.query.id",
+						},
+					},
+				},
+			}
+			enrichment, err := sec.enrichEvent(&dependency, event)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(string(event.Payload), convey.ShouldEqual, `{"id":"123"}`)
+			convey.So(enrichment["owner.team"], convey.ShouldEqual, `"payments"`)
+
+			sensor2.InitializeNode(sec.sensor, dependency.Name, v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+			sensor2.MarkNodePhase(sec.sensor, dependency.Name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, event, &sec.log, "event is received")
+			sec.recordEnrichment(dependency.Name, enrichment)
+			events := sec.extractEvents([]v1alpha1.ResourceParameter{
+				{
+					Src: &v1alpha1.ResourceParameterSource{
+						Event: dependency.Name,
+					},
+				},
+			})
+			enriched := events[dependency.Name]
+			convey.So(string(event.Payload), convey.ShouldEqual, `{"id":"123"}`)
+			convey.So(gjson.GetBytes(enriched.Payload, "id").String(), convey.ShouldEqual, "123")
+			convey.So(gjson.GetBytes(enriched.Payload, "owner.team").String(), convey.ShouldEqual, "payments")
+			convey.So(gjson.GetBytes(enriched.Payload, "contact.slack").String(), convey.ShouldEqual, "#payments")
+			convey.So(gjson.GetBytes(enriched.Payload, "review.status").String(), convey.ShouldEqual, "approved")
+		})
+
+		convey.Convey("Filters must refer to the results of the enrichments", func() {
+			dependency.Enrichments = []v1alpha1.Enrichment{
+				{
+					Name: "team",
+					Dest: "owner.team",
+					ConfigMap: &v1alpha1.ConfigMapLookup{
+						Name: "owners",
+						Key:  "team",
+					},
+				},
+			}
+			for team, filtered := range map[string]bool{
+				"billing":  true,
+				"payments": false,
+			} {
+				sensor2.InitializeNode(sec.sensor, dependency.Name, v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+				sensor2.MarkNodePhase(sec.sensor, dependency.Name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseActive, nil, &sec.log, "node is active")
+				dependency.Filters = v1alpha1.EventDependencyFilter{
+					Data: &v1alpha1.Data{
+						Filters: []*v1alpha1.DataFilter{
+							{
+								Path:  "owner.team",
+								Type:  v1alpha1.JSONTypeString,
+								Value: team,
+							},
+						},
+					},
+				}
+				ok, err := common.FilterEvent(dependency.Filters, event)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(ok, convey.ShouldBeFalse)
+
+				sec.processUpdateNotification(&updateNotification{
+					event:            event,
+					notificationType: v1alpha1.EventNotification,
+					writer:           &mockHttpWriter{},
+					eventDependency:  &dependency,
+				})
+				node := sensor2.GetNodeByName(sec.sensor, dependency.Name)
+				convey.So(node.Phase == v1alpha1.NodePhaseError, convey.ShouldEqual, filtered)
+			}
+			convey.So(string(event.Payload), convey.ShouldEqual, `{"id":"123"}`)
+		})
+
+		convey.Convey("Each event of a batch must keep the results of its own enrichments", func() {
+			dependency.Enrichments = []v1alpha1.Enrichment{
+				{
+					Name: "team",
+					ConfigMap: &v1alpha1.ConfigMapLookup{
+						Name: "owners",
+						Key:  "team",
+					},
+				},
+			}
+			dependency.Batch = &v1alpha1.Batch{
+				MaxEvents: 3,
+			}
+			sensor2.InitializeNode(sec.sensor, dependency.Name, v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+			for _, team := range []string{`"payments"`, `"billing"`} {
+				sec.addEventToBatch(&dependency, event, map[string]string{"team": team})
+			}
+			node := sensor2.GetNodeByName(sec.sensor, dependency.Name)
+			convey.So(len(node.BatchEnrichments), convey.ShouldEqual, 2)
+
+			events := sec.extractEvents([]v1alpha1.ResourceParameter{
+				{
+					Src: &v1alpha1.ResourceParameterSource{
+						Event: dependency.Name,
+					},
+				},
+			})
+			batch := events[dependency.Name]
+			convey.So(gjson.GetBytes(batch.Payload, "0.team").String(), convey.ShouldEqual, "payments")
+			convey.So(gjson.GetBytes(batch.Payload, "1.team").String(), convey.ShouldEqual, "billing")
+			convey.So(gjson.GetBytes(batch.Payload, "1.id").String(), convey.ShouldEqual, "123")
+		})
+
+		convey.Convey("A failed lookup must fail the enrichment", func() {
+			dependency.Enrichments = []v1alpha1.Enrichment{
+				{
+					Name: "review",
+					HTTP: &v1alpha1.HTTPLookup{
+						URL: server.URL,
+					},
+				},
+			}
+			_, err := sec.enrichEvent(&dependency, event)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
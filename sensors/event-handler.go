@@ -18,7 +18,6 @@ package sensors
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -33,7 +32,27 @@ import (
 
 // processUpdateNotification processes event received by sensor, validates it, updates the state of the node representing the event dependency
 func (sec *sensorExecutionCtx) processUpdateNotification(ew *updateNotification) {
+	var persistErr error
+	processed := ew.processed
 	defer func() {
+		// acknowledge the event once the updates, or the buffer of a suspended sensor, are persisted by this replica
+		if processed != nil {
+			processed <- persistErr
+		}
+	}()
+	defer func() {
+		// every replica of a scaled out sensor persists the state of its partition
+		if sec.isScaledOut() {
+			if err := sec.persistPartition(); err != nil {
+				sec.log.Error().Err(err).Msg("failed to persist partition, escalating...")
+				sec.escalatePartitionFailure("persist_partition")
+				persistErr = err
+			}
+		}
+		// only the leader among the replicas of a scaled out sensor persists the sensor resource
+		if !sec.isLeader() {
+			return
+		}
 		// persist updates to sensor resource
 		labels := map[string]string{
 			common.LabelSensorName:                    sec.sensor.Name,
@@ -43,15 +62,17 @@ func (sec *sensorExecutionCtx) processUpdateNotification(ew *updateNotification)
 		}
 		eventType := common.StateChangeEventType
 
-		updatedSensor, err := sn.PersistUpdates(sec.sensorClient, sec.sensor, sec.controllerInstanceID, &sec.log)
+		updatedSensor, err := sn.PersistUpdates(sec.sensorClient, sec.persistableSensor(), sec.controllerInstanceID, &sec.log)
 		if err != nil {
 			sec.log.Error().Err(err).Msg("failed to persist sensor update, escalating...")
 			// escalate failure
 			eventType = common.EscalationEventType
+			persistErr = err
 		}
 
 		// update sensor ref. in case of failure to persist updates, this is a deep copy of old sensor resource
 		sec.sensor = updatedSensor
+		sec.restoreNodeEvents()
 
 		labels[common.LabelEventType] = string(eventType)
 		if err := common.GenerateK8sEvent(sec.kubeClient, "persist update", eventType, "sensor resource update", sec.sensor.Name,
@@ -66,8 +87,45 @@ func (sec *sensorExecutionCtx) processUpdateNotification(ew *updateNotification)
 	case v1alpha1.EventNotification:
 		sec.log.Info().Str("event-dependency-name", ew.event.Context.Source.Host).Msg("received event notification")
 
+		// triggers are not executed while the sensor is suspended
+		if sec.sensor.Spec.Suspend {
+			persistErr = sec.suspendEvent(ew)
+			return
+		}
+
+		// enrich the event before the filters are applied, so the filters and the parameters of triggers can refer to the results.
+		// The filters see a copy of the event holding the results, the event itself is left unchanged.
+		var enrichment map[string]string
+		filtered := ew.event
+		if len(ew.eventDependency.Enrichments) > 0 {
+			var err error
+			enrichment, err = sec.enrichEvent(ew.eventDependency, ew.event)
+			if err == nil {
+				filtered, err = mergeEnrichment(ew.event, enrichment)
+			}
+			if err != nil {
+				sec.log.Error().Err(err).Str("event-dependency-name", ew.event.Context.Source.Host).Msg("failed to enrich event")
+
+				// escalate error
+				labels := map[string]string{
+					common.LabelEventType:   string(common.EscalationEventType),
+					common.LabelEventSource: ew.event.Context.Source.Host,
+					common.LabelSensorName:  sec.sensor.Name,
+					common.LabelOperation:   "enrich_event",
+				}
+				if err := common.GenerateK8sEvent(sec.kubeClient, "enrich event failed", common.OperationFailureEventType, "enriching event", sec.sensor.Name, sec.sensor.Namespace, sec.controllerInstanceID, sensor.Kind, labels); err != nil {
+					sec.log.Error().Err(err).Msg("failed to create K8s event to log enrichment error")
+				}
+
+				// change node state to error
+				sn.MarkNodePhase(sec.sensor, ew.event.Context.Source.Host, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseError, nil, &sec.log, fmt.Sprintf("failed to enrich event. err: %v", err))
+				sec.sendToDeadLetter(ew.event, fmt.Sprintf("failed to enrich event. err: %v", err), "")
+				return
+			}
+		}
+
 		// apply filters if any.
-		ok, err := sec.filterEvent(ew.eventDependency.Filters, ew.event)
+		ok, err := common.FilterEvent(ew.eventDependency.Filters, filtered)
 		if err != nil {
 			sec.log.Error().Err(err).Str("event-dependency-name", ew.event.Context.Source.Host).Err(err).Msg("failed to apply filter")
 
@@ -84,6 +142,7 @@ func (sec *sensorExecutionCtx) processUpdateNotification(ew *updateNotification)
 
 			// change node state to error
 			sn.MarkNodePhase(sec.sensor, ew.event.Context.Source.Host, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseError, nil, &sec.log, fmt.Sprintf("failed to apply filter. err: %v", err))
+			sec.sendToDeadLetter(ew.event, fmt.Sprintf("failed to apply filter. err: %v", err), "")
 			return
 		}
 
@@ -93,6 +152,35 @@ func (sec *sensorExecutionCtx) processUpdateNotification(ew *updateNotification)
 
 			// change node state to error
 			sn.MarkNodePhase(sec.sensor, ew.event.Context.Source.Host, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseError, nil, &sec.log, "event did not pass filters")
+			sec.sendToDeadLetter(ew.event, "event did not pass filters", "")
+			return
+		}
+
+		// events of a sequence must be received in the order of the dependencies
+		if sec.sensor.Spec.Sequence != nil && !sec.acceptInSequence(ew.eventDependency, ew.event) {
+			return
+		}
+
+		// the results of the enrichments are kept along with the event
+		if enrichment != nil {
+			sec.recordEnrichment(ew.eventDependency.Name, enrichment)
+		}
+
+		// events of an absence dependency meet or extend its deadline
+		if ew.eventDependency.Absence != nil {
+			sec.recordPresence(ew.eventDependency)
+			return
+		}
+
+		// events of a batching dependency are collected until the batch is complete
+		if ew.eventDependency.Batch != nil {
+			sec.addEventToBatch(ew.eventDependency, ew.event, enrichment)
+			return
+		}
+
+		// events of an aggregating dependency are aggregated until the aggregate crosses the threshold
+		if ew.eventDependency.Aggregation != nil {
+			sec.aggregateEvent(ew.eventDependency, ew.event)
 			return
 		}
 
@@ -103,8 +191,14 @@ func (sec *sensorExecutionCtx) processUpdateNotification(ew *updateNotification)
 
 	case v1alpha1.ResourceUpdateNotification:
 		sec.log.Info().Msg("sensor resource update")
+		wasSuspended := sec.sensor.Spec.Suspend
+		// a replica that is not the leader keeps its own state of the nodes, the status of the resource holds the partition of the leader
+		if !sec.isLeader() {
+			ew.sensor.Status = *sec.sensor.Status.DeepCopy()
+		}
 		// update sensor resource
 		sec.sensor = ew.sensor
+		sec.restoreNodeEvents()
 
 		hasDependenciesUpdated := false
 
@@ -128,6 +222,33 @@ func (sec *sensorExecutionCtx) processUpdateNotification(ew *updateNotification)
 			sec.NatsEventProtocol()
 		}
 
+		sec.armAbsenceDeadlines()
+
+		switch {
+		case !wasSuspended && sec.sensor.Spec.Suspend:
+			sec.log.Info().Msg("sensor is suspended")
+			sec.sensor.Status.Message = "sensor is suspended"
+		case wasSuspended && !sec.sensor.Spec.Suspend:
+			sec.sensor.Status.Message = "sensor is resumed"
+			sec.resumeSensor()
+		}
+
+	case v1alpha1.BatchWindowNotification:
+		sec.log.Info().Str("event-dependency-name", ew.eventDependency.Name).Msg("batch window has elapsed")
+		sec.completeBatchWindow(ew.eventDependency.Name, ew.batch)
+
+	case v1alpha1.AbsenceDeadlineNotification:
+		sec.log.Info().Str("event-dependency-name", ew.eventDependency.Name).Msg("absence deadline has passed")
+		sec.completeAbsenceDeadline(ew.eventDependency.Name, ew.absence)
+
+	case v1alpha1.ShutdownNotification:
+		sec.log.Info().Msg("stopping intake of events")
+		sec.stopIntake()
+
+	case v1alpha1.DebouncedTriggerNotification:
+		sec.log.Info().Str("trigger-name", ew.trigger).Msg("quiet period of debounced trigger has elapsed")
+		sec.executeDebouncedTrigger(ew.trigger)
+
 	default:
 		sec.log.Error().Str("notification-type", string(ew.notificationType)).Msg("unknown notification type")
 	}
@@ -135,23 +256,47 @@ func (sec *sensorExecutionCtx) processUpdateNotification(ew *updateNotification)
 
 // WatchEventsFromGateways watches and handles events received from the gateway.
 func (sec *sensorExecutionCtx) WatchEventsFromGateways() {
+	// restore the events of partially completed rounds, reopen their batches and arm the deadlines of absence event dependencies
+	// before processing the update notification queue
+	sec.restorePartition()
+	sec.initStateStore()
+	sec.restoreBatches()
+	sec.armAbsenceDeadlines()
+	// events buffered before a restart of a sensor that was resumed in the meantime are processed right away
+	if !sec.sensor.Spec.Suspend && len(sec.suspendedEvents) > 0 {
+		sec.resumeSensor()
+	}
+	sec.snapshotState()
+
+	if sec.isScaledOut() {
+		sec.runLeaderElection()
+	}
+
 	// start processing the update notification queue
-	go func() {
-		for e := range sec.queue {
-			sec.processUpdateNotification(e)
-		}
-	}()
+	go sec.processQueue()
 
 	// sync sensor resource after updates
 	go sec.syncSensor(context.Background())
 
+	// serverStopped is closed if the server receiving events over http stops on its own
+	var serverStopped chan struct{}
+
 	switch sec.sensor.Spec.EventProtocol.Type {
 	case pc.HTTP:
-		sec.HttpEventProtocol()
+		sec.server = &http.Server{Addr: fmt.Sprintf(":%s", sec.sensor.Spec.EventProtocol.Http.Port)}
+		serverStopped = make(chan struct{})
+		go func() {
+			sec.HttpEventProtocol()
+			close(serverStopped)
+		}()
 	case pc.NATS:
 		sec.NatsEventProtocol()
 		var err error
-		if sec.sensor, err = sn.PersistUpdates(sec.sensorClient, sec.sensor, sec.controllerInstanceID, &sec.log); err != nil {
+		if sec.isLeader() {
+			sec.sensor, err = sn.PersistUpdates(sec.sensorClient, sec.persistableSensor(), sec.controllerInstanceID, &sec.log)
+			sec.restoreNodeEvents()
+		}
+		if err != nil {
 			sec.log.Error().Err(err).Msg("failed to persist sensor update")
 			labels := map[string]string{
 				common.LabelEventType:  string(common.OperationFailureEventType),
@@ -162,13 +307,17 @@ func (sec *sensorExecutionCtx) WatchEventsFromGateways() {
 				sec.log.Error().Err(err).Msg("failed to create K8s event to log persist updates nats connection update failure")
 			}
 		}
-		select {}
+		sec.server = &http.Server{Addr: fmt.Sprintf(":%s", common.DefaultSensorAdminPort)}
+		go sec.adminServer()
 	}
+
+	// block until the sensor pod is terminated
+	sec.waitForShutdown(serverStopped)
 }
 
 // validateEvent validates whether the event is indeed from gateway that this sensor is watching
 func (sec *sensorExecutionCtx) validateEvent(events *apicommon.Event) (*ss_v1alpha1.EventDependency, bool) {
-	for _, event := range sec.sensor.Spec.Dependencies {
+	for _, event := range sec.sensorSpec().Dependencies {
 		if event.Name == events.Context.Source.Host {
 			return &event, true
 		}
@@ -176,27 +325,55 @@ func (sec *sensorExecutionCtx) validateEvent(events *apicommon.Event) (*ss_v1alp
 	return nil, false
 }
 
-func (sec *sensorExecutionCtx) parseEvent(payload []byte) (*apicommon.Event, error) {
-	var event *apicommon.Event
-	if err := json.Unmarshal(payload, &event); err != nil {
+// parseEvent parses an event in any of the formats gateways and CloudEvents producers send: CloudEvents 1.0 in binary mode,
+// which carries the attributes of the event in the headers of the request, or in structured mode, or the envelope of CloudEvents 0.1.
+// The header is nil for events received over NATS.
+func (sec *sensorExecutionCtx) parseEvent(header http.Header, payload []byte) (*apicommon.Event, error) {
+	event, err := common.DecodeCloudEvent(header, payload)
+	if err != nil {
 		response := "failed to parse event received from gateway"
 		sec.log.Error().Err(err).Msg(response)
 		return nil, err
 	}
+	if event.Context.Source == nil {
+		return nil, fmt.Errorf("event has no source")
+	}
 	return event, nil
 }
 
 func (sec *sensorExecutionCtx) sendEventToInternalQueue(event *apicommon.Event, writer http.ResponseWriter) bool {
+	return sec.queueEvent(event, writer, nil)
+}
+
+// sendEventToInternalQueueAndWait sends the event over the internal queue and waits until it is processed.
+// A non nil error indicates that the state of the sensor could not be persisted and the event must be redelivered.
+func (sec *sensorExecutionCtx) sendEventToInternalQueueAndWait(event *apicommon.Event, writer http.ResponseWriter) (bool, error) {
+	processed := make(chan error, 1)
+	if !sec.queueEvent(event, writer, processed) {
+		return false, nil
+	}
+	return true, <-processed
+}
+
+func (sec *sensorExecutionCtx) queueEvent(event *apicommon.Event, writer http.ResponseWriter, processed chan error) bool {
 	// validate whether the event is from gateway that this sensor is watching
 	if eventDependency, isValidEvent := sec.validateEvent(event); isValidEvent {
 		// process the event
-		sec.queue <- &updateNotification{
+		sec.enqueue(&updateNotification{
 			event:            event,
 			writer:           writer,
 			eventDependency:  eventDependency,
 			notificationType: v1alpha1.EventNotification,
-		}
+			processed:        processed,
+		})
 		return true
 	}
 	return false
 }
+
+// ackAfterProcessing returns true if events are acknowledged only once they are processed.
+// It is called by the handlers receiving events outside of the queue, so it reads the spec published by the queue.
+func (sec *sensorExecutionCtx) ackAfterProcessing() bool {
+	spec := sec.sensorSpec()
+	return spec.EventProtocol != nil && spec.EventProtocol.AckAfterProcessing
+}
@@ -17,8 +17,11 @@ limitations under the License.
 package sensors
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -104,6 +107,13 @@ func getsensorExecutionCtx(sensor *v1alpha1.Sensor) *sensorExecutionCtx {
 		sensorClient:         sensorFake.NewSimpleClientset(),
 		sensor:               sensor,
 		controllerInstanceID: "test-1",
+		triggerLimiters:      make(map[string]*triggerLimiter),
+		debounceTimers:       make(map[string]*time.Timer),
+		batches:              make(map[string]*eventBatch),
+		aggregations:         make(map[string][]aggregationSample),
+		absences:             make(map[string]*absenceDeadline),
+		storedEvents:         make(map[string]*nodeEvents),
+		done:                 make(chan struct{}),
 	}
 }
 
@@ -172,3 +182,42 @@ func TestEventHandler(t *testing.T) {
 
 	})
 }
+
+func TestAckAfterProcessing(t *testing.T) {
+	convey.Convey("Given a sensor that acknowledges events after processing", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sensor.Spec.EventProtocol = &v1alpha1.EventProtocol{
+			Type:               apicommon.HTTP,
+			AckAfterProcessing: true,
+		}
+		sec := getsensorExecutionCtx(sensor)
+		sensor2.InitializeNode(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+		sensor2.MarkNodePhase(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseActive, nil, &sec.log, "node is active")
+		sensor2.InitializeNode(sec.sensor, "test-workflow-trigger", v1alpha1.NodeTypeTrigger, &sec.log, "trigger is init")
+		sec.queue = make(chan *updateNotification)
+		go func() {
+			for e := range sec.queue {
+				sec.processUpdateNotification(e)
+			}
+		}()
+		defer close(sec.queue)
+
+		payload, err := json.Marshal(getCloudEvent())
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.Convey("The event must not be acknowledged if the state of the sensor is not persisted", func() {
+			recorder := httptest.NewRecorder()
+			sec.httpEventHandler(recorder, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(payload)))
+			convey.So(recorder.Code, convey.ShouldEqual, http.StatusInternalServerError)
+		})
+
+		convey.Convey("The event must be acknowledged once the state of the sensor is persisted", func() {
+			_, err := sec.sensorClient.ArgoprojV1alpha1().Sensors(sensor.Namespace).Create(sensor)
+			convey.So(err, convey.ShouldBeNil)
+			recorder := httptest.NewRecorder()
+			sec.httpEventHandler(recorder, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(payload)))
+			convey.So(recorder.Code, convey.ShouldEqual, http.StatusOK)
+		})
+	})
+}
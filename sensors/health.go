@@ -0,0 +1,226 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// stallTimeout is the time after which a sensor still processing the same notification is considered wedged
+	stallTimeout = 5 * time.Minute
+	// triggerHistoryLimit is the number of trigger executions kept in the trigger history
+	triggerHistoryLimit = 20
+)
+
+// sensorState is the state of the sensor exposed on the debug endpoint
+type sensorState struct {
+	// Name of the sensor
+	Name string `json:"name"`
+	// Replica is the index of the replica of the sensor
+	Replica int `json:"replica"`
+	// Leader indicates the replica persists the status of the sensor
+	Leader bool `json:"leader"`
+	// Suspended indicates the triggers are not executed
+	Suspended bool `json:"suspended"`
+	// CompletionCount is the number of trigger rounds
+	CompletionCount int32 `json:"completionCount"`
+	// Backlog is the number of notifications waiting to be processed
+	Backlog int64 `json:"backlog"`
+	// Dependencies is the state of the event dependencies
+	Dependencies []dependencyState `json:"dependencies"`
+	// TriggerHistory is the latest trigger executions, the most recent last
+	TriggerHistory []triggerExecution `json:"triggerHistory"`
+}
+
+// dependencyState is the state of an event dependency
+type dependencyState struct {
+	// Name of the event dependency
+	Name string `json:"name"`
+	// Phase of the node of the event dependency
+	Phase v1alpha1.NodePhase `json:"phase"`
+	// Message of the node of the event dependency
+	Message string `json:"message,omitempty"`
+	// LastEvent is the context of the latest event of the event dependency
+	LastEvent *apicommon.EventContext `json:"lastEvent,omitempty"`
+	// BatchedEvents is the number of events in the open batch of the event dependency
+	BatchedEvents int `json:"batchedEvents,omitempty"`
+}
+
+// triggerExecution is an execution of a trigger
+type triggerExecution struct {
+	// Trigger is the name of the trigger
+	Trigger string `json:"trigger"`
+	// Phase is the outcome of the execution
+	Phase v1alpha1.NodePhase `json:"phase"`
+	// Message describes the outcome of the execution
+	Message string `json:"message,omitempty"`
+	// Time of the execution
+	Time metav1.Time `json:"time"`
+}
+
+// registerAdminEndpoints registers the replay, health and introspection endpoints of the sensor
+func (sec *sensorExecutionCtx) registerAdminEndpoints() {
+	http.HandleFunc(common.SensorReplayEndpoint, sec.replayHandler)
+	http.HandleFunc(common.SensorHealthzEndpoint, sec.healthzHandler)
+	http.HandleFunc(common.SensorReadyzEndpoint, sec.readyzHandler)
+	http.HandleFunc(common.SensorDebugStateEndpoint, sec.debugStateHandler)
+}
+
+// recordTriggerExecution adds the execution of the trigger to the trigger history
+func (sec *sensorExecutionCtx) recordTriggerExecution(trigger string, phase v1alpha1.NodePhase, message string) {
+	sec.triggerHistory = append(sec.triggerHistory, triggerExecution{
+		Trigger: trigger,
+		Phase:   phase,
+		Message: message,
+		Time:    metav1.Time{Time: time.Now().UTC()},
+	})
+	if len(sec.triggerHistory) > triggerHistoryLimit {
+		sec.triggerHistory = sec.triggerHistory[len(sec.triggerHistory)-triggerHistoryLimit:]
+	}
+}
+
+// snapshotState publishes the state and the spec of the sensor for the debug endpoint and the other handlers.
+// It must be called from the queue, as the handlers of the endpoints can't read the sensor while it is updated.
+func (sec *sensorExecutionCtx) snapshotState() {
+	state := &sensorState{
+		Name:            sec.sensor.Name,
+		Replica:         sec.replica,
+		Leader:          sec.isLeader(),
+		Suspended:       sec.sensor.Spec.Suspend,
+		CompletionCount: sec.sensor.Status.CompletionCount,
+		TriggerHistory:  append([]triggerExecution(nil), sec.triggerHistory...),
+	}
+	for _, node := range sec.sensor.Status.Nodes {
+		if node.Type != v1alpha1.NodeTypeEventDependency {
+			continue
+		}
+		dependency := dependencyState{
+			Name:          node.Name,
+			Phase:         node.Phase,
+			Message:       node.Message,
+			BatchedEvents: len(node.Events),
+		}
+		if node.Event != nil {
+			dependency.LastEvent = node.Event.Context.DeepCopy()
+		}
+		state.Dependencies = append(state.Dependencies, dependency)
+	}
+	sort.Slice(state.Dependencies, func(i, j int) bool {
+		return state.Dependencies[i].Name < state.Dependencies[j].Name
+	})
+	sec.state.Store(state)
+	sec.spec.Store(sec.sensor.Spec.DeepCopy())
+}
+
+// sensorSpec returns the spec of the sensor for the handlers running outside of the queue.
+// The spec is the one last published by the queue, or the spec of the sensor if the queue hasn't started yet.
+func (sec *sensorExecutionCtx) sensorSpec() *v1alpha1.SensorSpec {
+	if spec, ok := sec.spec.Load().(*v1alpha1.SensorSpec); ok {
+		return spec
+	}
+	return &sec.sensor.Spec
+}
+
+// checkHealth returns an error if the queue is wedged processing a notification
+func (sec *sensorExecutionCtx) checkHealth(now time.Time) error {
+	since := atomic.LoadInt64(&sec.processingSince)
+	if since == 0 {
+		return nil
+	}
+	if elapsed := now.Sub(time.Unix(0, since)); elapsed > stallTimeout {
+		return fmt.Errorf("sensor has been processing the same notification for %s", elapsed.Round(time.Second))
+	}
+	return nil
+}
+
+// checkReadiness returns an error if the sensor can't receive events or can't keep up with them
+func (sec *sensorExecutionCtx) checkReadiness() error {
+	if backlog := atomic.LoadInt64(&sec.backlog); backlog > common.DefaultSensorMaxBacklog {
+		return fmt.Errorf("%d notifications are waiting to be processed", backlog)
+	}
+	if sec.nconn.standard == nil && sec.nconn.stream == nil {
+		return nil
+	}
+	if sec.nconn.standard != nil && !sec.nconn.standard.IsConnected() {
+		return fmt.Errorf("nats connection is not connected")
+	}
+	if sec.nconn.stream != nil {
+		if conn := sec.nconn.stream.NatsConn(); conn == nil || !conn.IsConnected() {
+			return fmt.Errorf("nats streaming connection is not connected")
+		}
+	}
+	if subscriptions, dependencies := atomic.LoadInt32(&sec.subscriptions), atomic.LoadInt32(&sec.dependencies); subscriptions < dependencies {
+		return fmt.Errorf("%d out of %d event dependencies are subscribed to", subscriptions, dependencies)
+	}
+	return nil
+}
+
+// healthzHandler responds with an error status if the sensor is wedged
+func (sec *sensorExecutionCtx) healthzHandler(w http.ResponseWriter, r *http.Request) {
+	if err := sec.checkHealth(time.Now()); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	common.SendSuccessResponse(w, "ok")
+}
+
+// readyzHandler responds with an error status if the sensor is not ready to receive events
+func (sec *sensorExecutionCtx) readyzHandler(w http.ResponseWriter, r *http.Request) {
+	if err := sec.checkReadiness(); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	common.SendSuccessResponse(w, "ok")
+}
+
+// debugStateHandler responds with the state of the sensor
+func (sec *sensorExecutionCtx) debugStateHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	state, ok := sec.state.Load().(*sensorState)
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("sensor state is not available yet"))
+		return
+	}
+	response := *state
+	response.Backlog = atomic.LoadInt64(&sec.backlog)
+	body, err := json.Marshal(&response)
+	if err != nil {
+		sec.log.Error().Err(err).Msg("failed to marshal sensor state")
+		common.SendInternalErrorResponse(w, "failed to marshal sensor state")
+		return
+	}
+	w.Header().Set("Content-Type", common.MediaTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
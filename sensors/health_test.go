@@ -0,0 +1,110 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	sensor2 "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestHealthEndpoints(t *testing.T) {
+	convey.Convey("Given a sensor", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sec := getsensorExecutionCtx(sensor)
+
+		convey.Convey("The sensor must be healthy while idle or processing a notification", func() {
+			now := time.Now()
+			convey.So(sec.checkHealth(now), convey.ShouldBeNil)
+			atomic.StoreInt64(&sec.processingSince, now.Add(-time.Second).UnixNano())
+			convey.So(sec.checkHealth(now), convey.ShouldBeNil)
+		})
+
+		convey.Convey("The sensor must be unhealthy once wedged on a notification", func() {
+			atomic.StoreInt64(&sec.processingSince, time.Now().Add(-2*stallTimeout).UnixNano())
+			w := httptest.NewRecorder()
+			sec.healthzHandler(w, httptest.NewRequest(http.MethodGet, common.SensorHealthzEndpoint, nil))
+			convey.So(w.Code, convey.ShouldEqual, http.StatusServiceUnavailable)
+		})
+
+		convey.Convey("The sensor must not be ready when it can't keep up with the events", func() {
+			w := httptest.NewRecorder()
+			sec.readyzHandler(w, httptest.NewRequest(http.MethodGet, common.SensorReadyzEndpoint, nil))
+			convey.So(w.Code, convey.ShouldEqual, http.StatusOK)
+
+			atomic.StoreInt64(&sec.backlog, common.DefaultSensorMaxBacklog+1)
+			w = httptest.NewRecorder()
+			sec.readyzHandler(w, httptest.NewRequest(http.MethodGet, common.SensorReadyzEndpoint, nil))
+			convey.So(w.Code, convey.ShouldEqual, http.StatusServiceUnavailable)
+		})
+	})
+}
+
+func TestDebugState(t *testing.T) {
+	convey.Convey("Given a sensor that received an event and executed a trigger", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sec := getsensorExecutionCtx(sensor)
+
+		sensor2.InitializeNode(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+		sensor2.MarkNodePhase(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, getCloudEvent(), &sec.log, "event received")
+		sensor2.InitializeNode(sec.sensor, "test-workflow-trigger", v1alpha1.NodeTypeTrigger, &sec.log, "trigger is init")
+		sec.recordTriggerExecution("test-workflow-trigger", v1alpha1.NodePhaseComplete, "successfully executed trigger")
+
+		convey.Convey("The state must not be available before the queue publishes it", func() {
+			w := httptest.NewRecorder()
+			sec.debugStateHandler(w, httptest.NewRequest(http.MethodGet, common.SensorDebugStateEndpoint, nil))
+			convey.So(w.Code, convey.ShouldEqual, http.StatusServiceUnavailable)
+		})
+
+		convey.Convey("The state must describe the dependencies and the trigger history", func() {
+			sec.snapshotState()
+			atomic.StoreInt64(&sec.backlog, 2)
+			w := httptest.NewRecorder()
+			sec.debugStateHandler(w, httptest.NewRequest(http.MethodGet, common.SensorDebugStateEndpoint, nil))
+			convey.So(w.Code, convey.ShouldEqual, http.StatusOK)
+
+			var state sensorState
+			convey.So(json.Unmarshal(w.Body.Bytes(), &state), convey.ShouldBeNil)
+			convey.So(state.Name, convey.ShouldEqual, sensor.Name)
+			convey.So(state.Backlog, convey.ShouldEqual, 2)
+			convey.So(state.Dependencies, convey.ShouldHaveLength, 1)
+			convey.So(state.Dependencies[0].Phase, convey.ShouldEqual, v1alpha1.NodePhaseComplete)
+			convey.So(state.Dependencies[0].LastEvent, convey.ShouldNotBeNil)
+			convey.So(state.TriggerHistory, convey.ShouldHaveLength, 1)
+			convey.So(state.TriggerHistory[0].Trigger, convey.ShouldEqual, "test-workflow-trigger")
+		})
+
+		convey.Convey("The trigger history must keep only the latest executions", func() {
+			for i := 0; i < 2*triggerHistoryLimit; i++ {
+				sec.recordTriggerExecution("test-workflow-trigger", v1alpha1.NodePhaseError, fmt.Sprintf("execution %d", i))
+			}
+			convey.So(sec.triggerHistory, convey.ShouldHaveLength, triggerHistoryLimit)
+			convey.So(sec.triggerHistory[triggerHistoryLimit-1].Message, convey.ShouldEqual, fmt.Sprintf("execution %d", 2*triggerHistoryLimit-1))
+		})
+	})
+}
@@ -25,16 +25,15 @@ import (
 	"github.com/argoproj/argo-events/pkg/apis/sensor"
 )
 
-// HttpEventProtocol handles events sent over HTTP
+// HttpEventProtocol handles events sent over HTTP, using the server of the sensor to listen for events from gateway
 func (sec *sensorExecutionCtx) HttpEventProtocol() {
-	// create a http server. this server listens for events from gateway.
-	sec.server = &http.Server{Addr: fmt.Sprintf(":%s", sec.sensor.Spec.EventProtocol.Http.Port)}
-
 	// add a handler to handle incoming events
 	http.HandleFunc("/", sec.httpEventHandler)
+	// add handlers to replay events and to check the health of the sensor
+	sec.registerAdminEndpoints()
 
 	sec.log.Info().Str("port", sec.sensor.Spec.EventProtocol.Http.Port).Msg("sensor started listening")
-	if err := sec.server.ListenAndServe(); err != nil {
+	if err := sec.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 		sec.log.Error().Err(err).Msg("sensor server stopped")
 		// escalate error
 		labels := map[string]string{
@@ -59,17 +58,33 @@ func (sec *sensorExecutionCtx) httpEventHandler(w http.ResponseWriter, r *http.R
 		response = "failed to read request body"
 		sec.log.Error().Err(err).Msg(response)
 		common.SendErrorResponse(w, response)
+		return
 	}
 
-	event, err := sec.parseEvent(body)
+	event, err := sec.parseEvent(r.Header, body)
 	if err != nil {
 		response = "failed to parse request into event"
 		sec.log.Error().Err(err).Msg(response)
 		common.SendErrorResponse(w, response)
+		return
 	}
 
-	// validate whether the event is from gateway that this sensor is watching and send event over internal queue if valid
-	if sec.sendEventToInternalQueue(event, w) {
+	// respond once the event is processed, so that the gateway retries if the state of the sensor is not persisted
+	if sec.ackAfterProcessing() {
+		ok, err := sec.sendEventToInternalQueueAndWait(event, w)
+		if ok {
+			if err != nil {
+				response = "failed to persist the state of the sensor"
+				sec.log.Error().Err(err).Str("event-source-name", event.Context.Source.Host).Msg(response)
+				common.SendInternalErrorResponse(w, response)
+				return
+			}
+			response = "event is processed"
+			sec.log.Info().Str("event-source-name", event.Context.Source.Host).Msg(response)
+			common.SendSuccessResponse(w, response)
+			return
+		}
+	} else if sec.sendEventToInternalQueue(event, w) {
 		response = "message successfully sent over internal queue"
 		sec.log.Info().Str("event-source-name", event.Context.Source.Host).Msg(response)
 		common.SendSuccessResponse(w, response)
@@ -20,6 +20,7 @@ import (
 	"github.com/argoproj/argo-events/pkg/apis/sensor"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/argoproj/argo-events/common"
@@ -92,6 +93,12 @@ func (sec *sensorExecutionCtx) escalateNatsSubscriptionFailure(eventSource strin
 func (sec *sensorExecutionCtx) NatsEventProtocol() {
 	var err error
 
+	// no new subscription is made once the sensor is shutting down
+	if sec.isShuttingDown() {
+		return
+	}
+	atomic.StoreInt32(&sec.dependencies, int32(len(sec.sensor.Spec.Dependencies)))
+
 	switch sec.sensor.Spec.EventProtocol.Nats.Type {
 	case pc.Standard:
 		if sec.nconn.standard == nil {
@@ -108,12 +115,15 @@ func (sec *sensorExecutionCtx) NatsEventProtocol() {
 			if dependency.Connected {
 				continue
 			}
-			if _, err := sec.getNatsStandardSubscription(dependency.Name); err != nil {
+			sub, err := sec.getNatsStandardSubscription(dependency.Name)
+			if err != nil {
 				// escalate failure
 				sec.escalateNatsSubscriptionFailure(dependency.Name)
 				sec.log.Error().Err(err).Str("event-source-name", dependency.Name).Msg("failed to get the nats subscription")
 				continue
 			}
+			sec.nconn.unsubscribers = append(sec.nconn.unsubscribers, sub.Unsubscribe)
+			atomic.AddInt32(&sec.subscriptions, 1)
 			dependency.Connected = true
 			// log success
 			sec.successNatsSubscription(dependency.Name)
@@ -132,11 +142,15 @@ func (sec *sensorExecutionCtx) NatsEventProtocol() {
 			if dependency.Connected {
 				continue
 			}
-			if _, err := sec.getNatsStreamingSubscription(dependency.Name); err != nil {
+			sub, err := sec.getNatsStreamingSubscription(dependency.Name)
+			if err != nil {
 				sec.escalateNatsSubscriptionFailure(dependency.Name)
 				sec.log.Error().Err(err).Str("event-source-name", dependency.Name).Msg("failed to get the nats subscription")
 				continue
 			}
+			// closing keeps the position of durable subscriptions, unlike unsubscribing
+			sec.nconn.unsubscribers = append(sec.nconn.unsubscribers, sub.Close)
+			atomic.AddInt32(&sec.subscriptions, 1)
 			dependency.Connected = true
 			sec.successNatsSubscription(dependency.Name)
 		}
@@ -145,7 +159,7 @@ func (sec *sensorExecutionCtx) NatsEventProtocol() {
 
 // getNatsStandardSubscription returns a standard nats subscription
 func (sec *sensorExecutionCtx) getNatsStandardSubscription(eventSource string) (*nats.Subscription, error) {
-	return sec.nconn.standard.QueueSubscribe(eventSource, common.DefaultNatsQueueName(eventSource), func(msg *nats.Msg) {
+	return sec.nconn.standard.QueueSubscribe(eventSource, sec.natsQueueName(eventSource), func(msg *nats.Msg) {
 		sec.processNatsMessage(msg.Data, eventSource)
 	})
 }
@@ -156,9 +170,31 @@ func (sec *sensorExecutionCtx) getNatsStreamingSubscription(eventSource string)
 	if err != nil {
 		return nil, err
 	}
-	return sec.nconn.stream.QueueSubscribe(eventSource, common.DefaultNatsQueueName(eventSource), func(msg *snats.Msg) {
-		sec.processNatsMessage(msg.Data, eventSource)
-	}, subscriptionOption)
+	options := []snats.SubscriptionOption{subscriptionOption}
+	if !sec.ackAfterProcessing() {
+		return sec.nconn.stream.QueueSubscribe(eventSource, sec.natsQueueName(eventSource), func(msg *snats.Msg) {
+			sec.processNatsMessage(msg.Data, eventSource)
+		}, options...)
+	}
+
+	// messages are acknowledged once processed, unacknowledged messages are redelivered
+	options = append(options, snats.SetManualAckMode())
+	if sec.sensor.Spec.EventProtocol.Nats.AckWait != "" {
+		ackWait, err := time.ParseDuration(sec.sensor.Spec.EventProtocol.Nats.AckWait)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, snats.AckWait(ackWait))
+	}
+	return sec.nconn.stream.QueueSubscribe(eventSource, sec.natsQueueName(eventSource), func(msg *snats.Msg) {
+		if err := sec.processNatsMessage(msg.Data, eventSource); err != nil {
+			sec.log.Warn().Err(err).Str("event-source-name", eventSource).Uint64("sequence", msg.Sequence).Msg("message is not acknowledged and will be redelivered")
+			return
+		}
+		if err := msg.Ack(); err != nil {
+			sec.log.Error().Err(err).Str("event-source-name", eventSource).Uint64("sequence", msg.Sequence).Msg("failed to acknowledge message")
+		}
+	}, options...)
 }
 
 // getNatsStreamingOption returns a streaming option
@@ -198,17 +234,30 @@ func (sec *sensorExecutionCtx) getNatsStreamingOption(eventSource string) (snats
 	}, nil
 }
 
-// processNatsMessage handles a nats message payload
-func (sec *sensorExecutionCtx) processNatsMessage(msg []byte, eventSource string) {
-	event, err := sec.parseEvent(msg)
+// processNatsMessage handles a nats message payload.
+// A non nil error indicates that the event must be redelivered, which only happens when events are acknowledged after processing.
+func (sec *sensorExecutionCtx) processNatsMessage(msg []byte, eventSource string) error {
+	event, err := sec.parseEvent(nil, msg)
 	if err != nil {
 		sec.log.Error().Err(err).Str("event-source-name", eventSource).Msg("failed to parse message into event")
-		return
+		return nil
+	}
+	// events of other partitions are processed by other replicas
+	if !sec.ownsEvent(event) {
+		sec.log.Debug().Str("event-source-name", eventSource).Str("event-id", event.Context.EventID).Msg("event belongs to the partition of another replica")
+		return nil
 	}
 	// validate whether the event is from gateway that this sensor is watching and send event over internal queue if valid
-	if sec.sendEventToInternalQueue(event, nil) {
+	if sec.ackAfterProcessing() {
+		ok, err := sec.sendEventToInternalQueueAndWait(event, nil)
+		if ok {
+			sec.log.Info().Str("event-source-name", eventSource).Msg("event is processed")
+			return err
+		}
+	} else if sec.sendEventToInternalQueue(event, nil) {
 		sec.log.Info().Str("event-source-name", eventSource).Msg("event successfully sent over internal queue")
-		return
+		return nil
 	}
 	sec.log.Warn().Str("event-source-name", eventSource).Msg("event is from unknown source")
+	return nil
 }
@@ -0,0 +1,211 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	snats "github.com/nats-io/go-nats-streaming"
+)
+
+// replayIdleTimeout is the time after which the replay of a nats streaming channel stops if no message is received
+const replayIdleTimeout = 2 * time.Second
+
+// replayRequest is a request to replay either a set of events or a range of a nats streaming channel
+type replayRequest struct {
+	// Events to replay
+	Events []apicommon.Event `json:"events,omitempty"`
+	// Nats is the range of the nats streaming channels to replay
+	Nats *natsReplay `json:"nats,omitempty"`
+}
+
+// natsReplay is a range of the nats streaming channels of the event dependencies.
+// One of startAtSequence or startAtTime must be specified.
+type natsReplay struct {
+	// StartAtSequence is the sequence of the first message to replay
+	StartAtSequence uint64 `json:"startAtSequence,omitempty"`
+	// StopAtSequence is the sequence of the last message to replay
+	StopAtSequence uint64 `json:"stopAtSequence,omitempty"`
+	// StartAtTime is the time of the first message to replay, formatted as common.StandardTimeFormat
+	StartAtTime string `json:"startAtTime,omitempty"`
+	// StopAtTime is the time of the last message to replay, formatted as common.StandardTimeFormat
+	StopAtTime string `json:"stopAtTime,omitempty"`
+	// Dependencies to replay. Defaults to all event dependencies.
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// replayHandler replays the events of the request through the filters and triggers of the sensor
+func (sec *sensorExecutionCtx) replayHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		sec.log.Error().Err(err).Msg("failed to read replay request body")
+		common.SendErrorResponse(w, "failed to read request body")
+		return
+	}
+	var req replayRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		sec.log.Error().Err(err).Msg("failed to parse replay request")
+		common.SendErrorResponse(w, "failed to parse replay request")
+		return
+	}
+
+	events := req.Events
+	if req.Nats != nil {
+		history, err := sec.fetchNatsHistory(req.Nats)
+		if err != nil {
+			sec.log.Error().Err(err).Msg("failed to fetch events from nats streaming")
+			common.SendErrorResponse(w, fmt.Sprintf("failed to fetch events from nats streaming. err: %+v", err))
+			return
+		}
+		events = append(events, history...)
+	}
+
+	replayed, skipped := sec.replayEvents(events)
+	response := fmt.Sprintf("replayed %d events, skipped %d events from unknown sources or of other partitions", replayed, skipped)
+	sec.log.Info().Int("replayed", replayed).Int("skipped", skipped).Msg("replayed events")
+	common.SendSuccessResponse(w, response)
+}
+
+// replayEvents marks the events as replayed and sends them over the internal queue in the order they happened.
+// Like live events, events of the partitions of other replicas are skipped.
+func (sec *sensorExecutionCtx) replayEvents(events []apicommon.Event) (replayed int, skipped int) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Context.EventTime.Before(&events[j].Context.EventTime)
+	})
+	for i := range events {
+		event := &events[i]
+		if event.Context.Source == nil {
+			skipped++
+			continue
+		}
+		if !sec.ownsEvent(event) {
+			sec.log.Debug().Str("event-id", event.Context.EventID).Msg("replayed event belongs to the partition of another replica")
+			skipped++
+			continue
+		}
+		if event.Context.Extensions == nil {
+			event.Context.Extensions = make(map[string]string)
+		}
+		event.Context.Extensions[common.EventExtensionReplayed] = "true"
+		if sec.sendEventToInternalQueue(event, nil) {
+			replayed++
+			continue
+		}
+		skipped++
+	}
+	return replayed, skipped
+}
+
+// fetchNatsHistory reads the range of the nats streaming channels of the event dependencies.
+// The channels are read until the end of the range, or until no message is received for a while.
+func (sec *sensorExecutionCtx) fetchNatsHistory(replay *natsReplay) ([]apicommon.Event, error) {
+	// the handler runs outside of the queue, which replaces the sensor while it is updated
+	spec := sec.sensorSpec()
+	protocol := spec.EventProtocol
+	if protocol == nil || protocol.Type != apicommon.NATS || protocol.Nats.Type != apicommon.Streaming || sec.nconn.stream == nil {
+		return nil, fmt.Errorf("sensor does not receive events over nats streaming")
+	}
+
+	var start snats.SubscriptionOption
+	var stopAtTime time.Time
+	switch {
+	case replay.StartAtSequence > 0:
+		start = snats.StartAtSequence(replay.StartAtSequence)
+	case replay.StartAtTime != "":
+		startTime, err := time.Parse(common.StandardTimeFormat, replay.StartAtTime)
+		if err != nil {
+			return nil, err
+		}
+		start = snats.StartAtTime(startTime)
+	default:
+		return nil, fmt.Errorf("either startAtSequence or startAtTime must be specified")
+	}
+	if replay.StopAtTime != "" {
+		var err error
+		if stopAtTime, err = time.Parse(common.StandardTimeFormat, replay.StopAtTime); err != nil {
+			return nil, err
+		}
+	}
+
+	dependencies := replay.Dependencies
+	if len(dependencies) == 0 {
+		for _, dependency := range spec.Dependencies {
+			dependencies = append(dependencies, dependency.Name)
+		}
+	}
+
+	var events []apicommon.Event
+	for _, dependency := range dependencies {
+		msgs := make(chan *snats.Msg)
+		done := make(chan struct{})
+		sub, err := sec.nconn.stream.Subscribe(dependency, func(msg *snats.Msg) {
+			select {
+			case msgs <- msg:
+			case <-done:
+			}
+		}, start)
+		if err != nil {
+			return nil, err
+		}
+
+	read:
+		for {
+			select {
+			case msg := <-msgs:
+				if replay.StopAtSequence > 0 && msg.Sequence > replay.StopAtSequence {
+					break read
+				}
+				if !stopAtTime.IsZero() && time.Unix(0, msg.Timestamp).After(stopAtTime) {
+					break read
+				}
+				event, err := sec.parseEvent(nil, msg.Data)
+				if err != nil {
+					sec.log.Warn().Err(err).Str("event-dependency-name", dependency).Uint64("sequence", msg.Sequence).Msg("failed to parse message into event, skipping")
+					continue
+				}
+				events = append(events, *event)
+			case <-time.After(replayIdleTimeout):
+				break read
+			}
+		}
+		close(done)
+		if err := sub.Unsubscribe(); err != nil {
+			sec.log.Warn().Err(err).Str("event-dependency-name", dependency).Msg("failed to unsubscribe from replayed channel")
+		}
+	}
+	return events, nil
+}
+
+// adminServer serves the replay, health and introspection endpoints for sensors that do not run a http server to receive events
+func (sec *sensorExecutionCtx) adminServer() {
+	sec.registerAdminEndpoints()
+	sec.log.Info().Str("port", common.DefaultSensorAdminPort).Msg("admin server started listening")
+	if err := sec.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		sec.log.Error().Err(err).Msg("admin server stopped")
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestReplayHandler(t *testing.T) {
+	convey.Convey("Given a sensor", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sec := getsensorExecutionCtx(sensor)
+		sec.queue = make(chan *updateNotification, 10)
+
+		convey.Convey("Replayed events must be tagged and sent over the internal queue", func() {
+			known := getCloudEvent()
+			unknown := getCloudEvent()
+			unknown.Context.Source = &apicommon.URI{
+				Host: "unknown-gateway:test",
+			}
+			body, err := json.Marshal(&replayRequest{
+				Events: []apicommon.Event{*known, *unknown},
+			})
+			convey.So(err, convey.ShouldBeNil)
+
+			rr := httptest.NewRecorder()
+			sec.replayHandler(rr, httptest.NewRequest(http.MethodPost, common.SensorReplayEndpoint, bytes.NewReader(body)))
+			convey.So(rr.Code, convey.ShouldEqual, http.StatusOK)
+			convey.So(rr.Body.String(), convey.ShouldEqual, "replayed 1 events, skipped 1 events from unknown sources or of other partitions")
+
+			convey.So(len(sec.queue), convey.ShouldEqual, 1)
+			notification := <-sec.queue
+			convey.So(notification.event.Context.Extensions[common.EventExtensionReplayed], convey.ShouldEqual, "true")
+		})
+
+		convey.Convey("Replayed events must be validated against the spec published by the queue", func() {
+			sec.snapshotState()
+			updated := sec.sensor.DeepCopy()
+			updated.Spec.Dependencies = nil
+			sec.sensor = updated
+
+			replayed, skipped := sec.replayEvents([]apicommon.Event{*getCloudEvent()})
+			convey.So(replayed, convey.ShouldEqual, 1)
+			convey.So(skipped, convey.ShouldEqual, 0)
+			<-sec.queue
+
+			sec.snapshotState()
+			replayed, skipped = sec.replayEvents([]apicommon.Event{*getCloudEvent()})
+			convey.So(replayed, convey.ShouldEqual, 0)
+			convey.So(skipped, convey.ShouldEqual, 1)
+		})
+
+		convey.Convey("Replayed events of the partitions of other replicas must be skipped", func() {
+			sec.sensor.Spec.Scaling = &v1alpha1.Scaling{
+				Replicas: 2,
+				Key:      "order.id",
+			}
+			sec.replica = 1
+			event := getCloudEvent()
+			event.Payload = []byte(`{"order": {}}`)
+
+			replayed, skipped := sec.replayEvents([]apicommon.Event{*event})
+			convey.So(replayed, convey.ShouldEqual, 0)
+			convey.So(skipped, convey.ShouldEqual, 1)
+			convey.So(len(sec.queue), convey.ShouldEqual, 0)
+		})
+
+		convey.Convey("Replaying a nats streaming range must fail for a sensor that does not receive events over nats streaming", func() {
+			body, err := json.Marshal(&replayRequest{
+				Nats: &natsReplay{
+					StartAtSequence: 1,
+				},
+			})
+			convey.So(err, convey.ShouldBeNil)
+
+			rr := httptest.NewRecorder()
+			sec.replayHandler(rr, httptest.NewRequest(http.MethodPost, common.SensorReplayEndpoint, bytes.NewReader(body)))
+			convey.So(rr.Code, convey.ShouldEqual, http.StatusBadRequest)
+		})
+	})
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"sync/atomic"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/tidwall/gjson"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// leaderLeaseDuration is the time after which the lease of a leader that stopped renewing it can be taken over
+	leaderLeaseDuration = 15 * time.Second
+	// leaderLeaseRetryPeriod is the period at which the replicas try to acquire or renew the lease
+	leaderLeaseRetryPeriod = 5 * time.Second
+	// leaderLeaseAnnotation is the annotation of the lease configmap holding the lease
+	leaderLeaseAnnotation = "sensors.argoproj.io/leader"
+	// partitionNodesKey is the key of the partition configmap holding the event dependency nodes of the partition
+	partitionNodesKey = "nodes"
+)
+
+// leaderLease is the lease of the leader among the replicas of a sensor
+type leaderLease struct {
+	// Holder is the replica holding the lease
+	Holder string `json:"holder"`
+	// RenewTime is the last time the holder renewed the lease
+	RenewTime metav1.Time `json:"renewTime"`
+}
+
+// isScaledOut returns true if the sensor runs as more than one replica.
+// It is called by the nats subscriptions outside of the queue, so it reads the spec published by the queue.
+func (sec *sensorExecutionCtx) isScaledOut() bool {
+	scaling := sec.sensorSpec().Scaling
+	return scaling != nil && scaling.Replicas > 1
+}
+
+// isLeader returns true if this replica persists the status of the sensor
+func (sec *sensorExecutionCtx) isLeader() bool {
+	if !sec.isScaledOut() {
+		return true
+	}
+	return atomic.LoadInt32(&sec.leader) == 1
+}
+
+// natsQueueName returns the queue group of the subscription to the event source.
+// Every replica of a scaled out sensor must receive all the events to keep those of its partition, so each replica has its own queue group.
+func (sec *sensorExecutionCtx) natsQueueName(eventSource string) string {
+	if sec.isScaledOut() {
+		return common.DefaultSensorReplicaName(common.DefaultNatsQueueName(eventSource), sec.replica)
+	}
+	return common.DefaultNatsQueueName(eventSource)
+}
+
+// ownsEvent returns true if the event belongs to the partition of this replica.
+// The key is read from the event data rendered as JSON, so events whose data can't be rendered belong to the first partition.
+func (sec *sensorExecutionCtx) ownsEvent(event *apicommon.Event) bool {
+	if !sec.isScaledOut() {
+		return true
+	}
+	scaling := sec.sensorSpec().Scaling
+	var key string
+	js, err := common.RenderEventDataAsJSON(event)
+	if err != nil {
+		sec.log.Debug().Err(err).Str("event-id", event.Context.EventID).Msg("failed to render event data as JSON, event has no key")
+	} else {
+		key = gjson.GetBytes(js, scaling.Key).String()
+	}
+	return partitionOf(key, int(scaling.Replicas)) == sec.replica
+}
+
+// partitionOf returns the partition of the key. Empty keys belong to the first partition.
+func partitionOf(key string, partitions int) int {
+	if key == "" {
+		return 0
+	}
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32() % uint32(partitions))
+}
+
+// acquireLeaderLease acquires or renews the lease of the leader of the replicas of the sensor
+func (sec *sensorExecutionCtx) acquireLeaderLease(owner *v1alpha1.Sensor, identity string, now time.Time) (bool, error) {
+	lease, err := json.Marshal(&leaderLease{
+		Holder:    identity,
+		RenewTime: metav1.Time{Time: now},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	name := common.DefaultSensorLeaderLeaseName(owner.Name)
+	configmaps := sec.kubeClient.CoreV1().ConfigMaps(owner.Namespace)
+	cm, err := configmaps.Get(name, metav1.GetOptions{})
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return false, err
+		}
+		_, err = configmaps.Create(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: owner.Namespace,
+				Labels: map[string]string{
+					common.LabelSensorName: owner.Name,
+				},
+				Annotations: map[string]string{
+					leaderLeaseAnnotation: string(lease),
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(owner, v1alpha1.SchemaGroupVersionKind),
+				},
+			},
+		})
+		if apierr.IsAlreadyExists(err) {
+			// another replica created the lease first
+			return false, nil
+		}
+		return err == nil, err
+	}
+
+	var current leaderLease
+	if value, ok := cm.Annotations[leaderLeaseAnnotation]; ok {
+		if err := json.Unmarshal([]byte(value), &current); err != nil {
+			sec.log.Warn().Err(err).Msg("failed to parse leader lease, taking it over")
+		}
+	}
+	if current.Holder != "" && current.Holder != identity && now.Sub(current.RenewTime.Time) < leaderLeaseDuration {
+		return false, nil
+	}
+
+	if cm.Annotations == nil {
+		cm.Annotations = make(map[string]string)
+	}
+	cm.Annotations[leaderLeaseAnnotation] = string(lease)
+	if _, err := configmaps.Update(cm); err != nil {
+		if apierr.IsConflict(err) {
+			// another replica updated the lease in the meantime
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// electLeader tries to acquire or renew the lease and updates the leadership of this replica
+func (sec *sensorExecutionCtx) electLeader(owner *v1alpha1.Sensor, identity string) {
+	leader, err := sec.acquireLeaderLease(owner, identity, time.Now().UTC())
+	if err != nil {
+		// step down as the lease can't be renewed
+		sec.log.Error().Err(err).Str("identity", identity).Msg("failed to acquire leader lease")
+		leader = false
+	}
+	var value int32
+	if leader {
+		value = 1
+	}
+	if atomic.SwapInt32(&sec.leader, value) != value {
+		sec.log.Info().Str("identity", identity).Bool("leader", leader).Msg("leadership changed")
+	}
+}
+
+// runLeaderElection elects the leader among the replicas of the sensor.
+// The leader is the only replica to persist the status of the sensor. Each replica persists the state of its partition in its own configmap.
+// It must be called before the update notification queue is processed. The election stops once the sensor is shut down.
+func (sec *sensorExecutionCtx) runLeaderElection() {
+	// the lease is owned by the sensor resource, which the queue replaces on updates
+	owner := sec.sensor.DeepCopy()
+	identity := common.DefaultSensorReplicaName(owner.Name, sec.replica)
+	sec.electLeader(owner, identity)
+	go func() {
+		ticker := time.NewTicker(leaderLeaseRetryPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				sec.electLeader(owner, identity)
+			case <-sec.done:
+				// the lease is left to expire, as the final state of the sensor is already persisted
+				atomic.StoreInt32(&sec.leader, 0)
+				sec.log.Info().Str("identity", identity).Msg("stopped leader election")
+				return
+			}
+		}
+	}()
+}
+
+// persistPartition persists the event dependency nodes of the partition of this replica of a scaled out sensor in its partition configmap,
+// so the replica resumes the rounds of its partition after a restart whether or not it is the leader.
+// The events of the nodes are kept in the state store if the sensor has one.
+func (sec *sensorExecutionCtx) persistPartition() error {
+	persistable := sec.persistableSensor()
+	nodes := make(map[string]v1alpha1.NodeStatus)
+	for id, node := range persistable.Status.Nodes {
+		if node.Type == v1alpha1.NodeTypeEventDependency {
+			nodes[id] = node
+		}
+	}
+	value, err := json.Marshal(nodes)
+	if err != nil {
+		return err
+	}
+	if string(value) == sec.partition {
+		return nil
+	}
+
+	name := common.DefaultSensorPartitionName(sec.sensor.Name, sec.replica)
+	configmaps := sec.kubeClient.CoreV1().ConfigMaps(sec.sensor.Namespace)
+	cm, err := configmaps.Get(name, metav1.GetOptions{})
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return err
+		}
+		_, err = configmaps.Create(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: sec.sensor.Namespace,
+				Labels: map[string]string{
+					common.LabelSensorName: sec.sensor.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(sec.sensor, v1alpha1.SchemaGroupVersionKind),
+				},
+			},
+			Data: map[string]string{
+				partitionNodesKey: string(value),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		sec.partition = string(value)
+		return nil
+	}
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[partitionNodesKey] = string(value)
+	if _, err := configmaps.Update(cm); err != nil {
+		return err
+	}
+	sec.partition = string(value)
+	return nil
+}
+
+// restorePartition restores the event dependency nodes of the partition of this replica of a scaled out sensor from its partition configmap.
+// The status of the sensor resource holds the partition of the leader, so a replica without a partition configmap starts with
+// fresh event dependency nodes, except for the first replica that keeps the state of a sensor that was just scaled out.
+// It must be called before the events of the nodes are restored from the state store.
+func (sec *sensorExecutionCtx) restorePartition() {
+	if !sec.isScaledOut() {
+		return
+	}
+	name := common.DefaultSensorPartitionName(sec.sensor.Name, sec.replica)
+	cm, err := sec.kubeClient.CoreV1().ConfigMaps(sec.sensor.Namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			sec.log.Error().Err(err).Str("configmap", name).Msg("failed to restore partition")
+			sec.escalatePartitionFailure("restore_partition")
+			return
+		}
+		if sec.replica == 0 {
+			return
+		}
+		for id, node := range sec.sensor.Status.Nodes {
+			if node.Type != v1alpha1.NodeTypeEventDependency {
+				continue
+			}
+			node.Phase = v1alpha1.NodePhaseActive
+			node.Message = "event dependency is active"
+			node.Event = nil
+			node.Events = nil
+			node.EventRef = ""
+			node.Enrichment = nil
+			node.BatchEnrichments = nil
+			sec.sensor.Status.Nodes[id] = node
+		}
+		return
+	}
+
+	var nodes map[string]v1alpha1.NodeStatus
+	if err := json.Unmarshal([]byte(cm.Data[partitionNodesKey]), &nodes); err != nil {
+		sec.log.Error().Err(err).Str("configmap", name).Msg("failed to parse partition")
+		sec.escalatePartitionFailure("restore_partition")
+		return
+	}
+	for id, node := range nodes {
+		// nodes of event dependencies removed in the meantime are dropped
+		if _, ok := sec.sensor.Status.Nodes[id]; ok {
+			sec.sensor.Status.Nodes[id] = node
+		}
+	}
+	sec.partition = cm.Data[partitionNodesKey]
+	sec.log.Info().Int("nodes", len(nodes)).Msg("restored partition")
+}
+
+// escalatePartitionFailure creates a K8s event to escalate a failure to persist or restore the partition of this replica
+func (sec *sensorExecutionCtx) escalatePartitionFailure(operation string) {
+	labels := map[string]string{
+		common.LabelEventType:  string(common.EscalationEventType),
+		common.LabelSensorName: sec.sensor.Name,
+		common.LabelOperation:  operation,
+	}
+	if err := common.GenerateK8sEvent(sec.kubeClient, "partition operation failed", common.EscalationEventType, "sensor partition", sec.sensor.Name, sec.sensor.Namespace, sec.controllerInstanceID, sensor.Kind, labels); err != nil {
+		sec.log.Error().Err(err).Msg("failed to create K8s event to escalate partition failure")
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	sensor2 "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestPartitioning(t *testing.T) {
+	convey.Convey("Given a sensor scaled out to three replicas partitioned by key", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sensor.Spec.Scaling = &v1alpha1.Scaling{
+			Replicas: 3,
+			Key:      "order.id",
+		}
+		replicas := make([]*sensorExecutionCtx, 3)
+		for i := range replicas {
+			replicas[i] = getsensorExecutionCtx(sensor)
+			replicas[i].replica = i
+		}
+
+		convey.Convey("Each replica must have its own queue group", func() {
+			convey.So(replicas[0].natsQueueName("test-gateway:test"), convey.ShouldNotEqual, replicas[1].natsQueueName("test-gateway:test"))
+		})
+
+		convey.Convey("Each event must be owned by exactly one replica", func() {
+			for i := 0; i < 20; i++ {
+				event := getCloudEvent()
+				event.Payload = []byte(fmt.Sprintf(`{"order": {"id": "order-%d"}}`, i))
+				owners := 0
+				for _, replica := range replicas {
+					if replica.ownsEvent(event) {
+						owners++
+					}
+				}
+				convey.So(owners, convey.ShouldEqual, 1)
+			}
+		})
+
+		convey.Convey("Events without a key must be owned by the first replica", func() {
+			event := getCloudEvent()
+			event.Payload = []byte(`{"order": {}}`)
+			convey.So(replicas[0].ownsEvent(event), convey.ShouldBeTrue)
+			convey.So(replicas[1].ownsEvent(event), convey.ShouldBeFalse)
+		})
+
+		convey.Convey("Events that are not JSON must be partitioned by the key of their data", func() {
+			owners := make(map[int]bool)
+			for i := 0; i < 20; i++ {
+				event := getCloudEvent()
+				event.Context.ContentType = common.MediaTypeYAML
+				event.Payload = []byte(fmt.Sprintf("order:\n  id: order-%d\n", i))
+				for _, replica := range replicas {
+					if replica.ownsEvent(event) {
+						owners[replica.replica] = true
+					}
+				}
+			}
+			convey.So(len(owners), convey.ShouldBeGreaterThan, 1)
+		})
+	})
+}
+
+func TestLeaderLease(t *testing.T) {
+	convey.Convey("Given two replicas of a sensor", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sensor.Spec.Scaling = &v1alpha1.Scaling{
+			Replicas: 2,
+		}
+		sec := getsensorExecutionCtx(sensor)
+		now := time.Now().UTC()
+
+		convey.Convey("Only one replica must hold the lease", func() {
+			leader, err := sec.acquireLeaderLease(sensor, "replica-0", now)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(leader, convey.ShouldBeTrue)
+
+			leader, err = sec.acquireLeaderLease(sensor, "replica-1", now.Add(time.Second))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(leader, convey.ShouldBeFalse)
+
+			leader, err = sec.acquireLeaderLease(sensor, "replica-0", now.Add(2*time.Second))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(leader, convey.ShouldBeTrue)
+
+			convey.Convey("The lease must be taken over once the leader stops renewing it", func() {
+				leader, err := sec.acquireLeaderLease(sensor, "replica-1", now.Add(2*time.Second+leaderLeaseDuration))
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(leader, convey.ShouldBeTrue)
+			})
+		})
+
+		convey.Convey("A replica must not persist the sensor without holding the lease", func() {
+			convey.So(sec.isLeader(), convey.ShouldBeFalse)
+			sec.electLeader(sensor, "replica-0")
+			convey.So(sec.isLeader(), convey.ShouldBeTrue)
+		})
+
+		convey.Convey("The replica must step down once the sensor is shut down", func() {
+			sec.runLeaderElection()
+			convey.So(sec.isLeader(), convey.ShouldBeTrue)
+			sec.closeConnections()
+			convey.So(func() bool {
+				for i := 0; i < 100 && sec.isLeader(); i++ {
+					time.Sleep(10 * time.Millisecond)
+				}
+				return sec.isLeader()
+			}(), convey.ShouldBeFalse)
+		})
+	})
+}
+
+func TestPartitionState(t *testing.T) {
+	convey.Convey("Given a replica of a scaled out sensor that is not the leader", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sensor.Spec.Scaling = &v1alpha1.Scaling{
+			Replicas: 2,
+		}
+		sec := getsensorExecutionCtx(sensor)
+		sec.replica = 1
+		convey.So(sec.isLeader(), convey.ShouldBeFalse)
+		sensor2.InitializeNode(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+		initial := sec.sensor.DeepCopy()
+
+		convey.Convey("The state of its partition must be persisted and restored after a restart", func() {
+			sensor2.MarkNodePhase(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, getCloudEvent(), &sec.log, "event is received")
+			convey.So(sec.persistPartition(), convey.ShouldBeNil)
+
+			restarted := getsensorExecutionCtx(initial)
+			restarted.kubeClient = sec.kubeClient
+			restarted.replica = 1
+			restarted.restorePartition()
+			node := sensor2.GetNodeByName(restarted.sensor, "test-gateway:test")
+			convey.So(node.Phase, convey.ShouldEqual, v1alpha1.NodePhaseComplete)
+			convey.So(string(node.Event.Payload), convey.ShouldEqual, string(getCloudEvent().Payload))
+		})
+
+		convey.Convey("A replica without a persisted partition must not take over the state of the leader", func() {
+			sensor2.MarkNodePhase(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, getCloudEvent(), &sec.log, "event is received")
+			sec.restorePartition()
+			node := sensor2.GetNodeByName(sec.sensor, "test-gateway:test")
+			convey.So(node.Phase, convey.ShouldEqual, v1alpha1.NodePhaseActive)
+			convey.So(node.Event, convey.ShouldBeNil)
+		})
+	})
+}
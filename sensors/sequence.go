@@ -0,0 +1,111 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"fmt"
+	"time"
+
+	sn "github.com/argoproj/argo-events/controllers/sensor"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// acceptInSequence checks whether the event of the dependency is received in the order of the sequence,
+// i.e. all preceding dependencies are resolved and none of the following ones.
+// An event of the first dependency always starts the sequence over. Other events received out of order are ignored,
+// and start the sequence over if the out of order policy is Reset.
+func (sec *sensorExecutionCtx) acceptInSequence(dependency *v1alpha1.EventDependency, event *apicommon.Event) bool {
+	sequence := sec.sensor.Spec.Sequence
+	dependencies := sec.sensor.Spec.Dependencies
+
+	// the sequence starts over once the events are too far apart
+	if sequence.Within != "" {
+		within, err := time.ParseDuration(sequence.Within)
+		if err != nil {
+			sec.log.Error().Err(err).Msg("failed to parse sequence duration")
+			sn.MarkNodePhase(sec.sensor, dependency.Name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseError, nil, &sec.log, fmt.Sprintf("failed to parse sequence duration. err: %+v", err))
+			return false
+		}
+		if start, ok := sec.sequenceStart(); ok && eventTime(event).Sub(start) > within {
+			sec.resetSequence(fmt.Sprintf("sequence did not complete within %s", sequence.Within))
+		}
+	}
+
+	position := -1
+	for i, ed := range dependencies {
+		if ed.Name == dependency.Name {
+			position = i
+			break
+		}
+	}
+
+	for i, ed := range dependencies {
+		if i == position {
+			continue
+		}
+		node := sn.GetNodeByName(sec.sensor, ed.Name)
+		resolved := node != nil && node.Phase == v1alpha1.NodePhaseComplete
+		if (i < position) == resolved {
+			continue
+		}
+
+		if position == 0 {
+			sec.resetSequence(fmt.Sprintf("sequence is started over by event of %s", dependency.Name))
+			return true
+		}
+
+		reason := fmt.Sprintf("event of %s is received out of order", dependency.Name)
+		sec.log.Warn().Str("event-dependency-name", dependency.Name).Str("out-of-order-policy", string(sequence.OutOfOrderPolicy)).Msg("event is received out of order")
+		if sequence.OutOfOrderPolicy == v1alpha1.OutOfOrderReset {
+			sec.resetSequence(reason)
+		} else if node := sn.GetNodeByName(sec.sensor, dependency.Name); node != nil {
+			node.Message = fmt.Sprintf("%s and is ignored", reason)
+			sec.sensor.Status.Nodes[node.ID] = *node
+		}
+		return false
+	}
+	return true
+}
+
+// sequenceStart returns the time of the event that resolved the first dependency of the sequence
+func (sec *sensorExecutionCtx) sequenceStart() (time.Time, bool) {
+	if len(sec.sensor.Spec.Dependencies) == 0 {
+		return time.Time{}, false
+	}
+	node := sn.GetNodeByName(sec.sensor, sec.sensor.Spec.Dependencies[0].Name)
+	if node == nil || node.Phase != v1alpha1.NodePhaseComplete || node.Event == nil {
+		return time.Time{}, false
+	}
+	return eventTime(node.Event), true
+}
+
+// resetSequence marks all dependencies of the sequence as active
+func (sec *sensorExecutionCtx) resetSequence(reason string) {
+	sec.log.Info().Str("reason", reason).Msg("resetting sequence")
+	for _, dep := range sec.sensor.Spec.Dependencies {
+		sn.MarkNodePhase(sec.sensor, dep.Name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseActive, nil, &sec.log, fmt.Sprintf("sequence is reset. %s", reason))
+	}
+}
+
+// eventTime returns the time the event happened, or the current time if the event does not carry one
+func eventTime(event *apicommon.Event) time.Time {
+	if event.Context.EventTime.IsZero() {
+		return time.Now().UTC()
+	}
+	return event.Context.EventTime.Time
+}
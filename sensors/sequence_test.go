@@ -0,0 +1,83 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"testing"
+	"time"
+
+	sensor2 "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSequence(t *testing.T) {
+	convey.Convey("Given a sensor with a sequence of two event dependencies", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sensor.Spec.Dependencies = append(sensor.Spec.Dependencies, v1alpha1.EventDependency{
+			Name: "test-gateway:test2",
+		})
+		sensor.Spec.Sequence = &v1alpha1.Sequence{
+			Within: "15m",
+		}
+		sec := getsensorExecutionCtx(sensor)
+		for _, dep := range sec.sensor.Spec.Dependencies {
+			sensor2.InitializeNode(sec.sensor, dep.Name, v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+			sensor2.MarkNodePhase(sec.sensor, dep.Name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseActive, nil, &sec.log, "node is active")
+		}
+		first := sec.sensor.Spec.Dependencies[0]
+		second := sec.sensor.Spec.Dependencies[1]
+
+		convey.Convey("An event of the second dependency must be ignored before the first one is resolved", func() {
+			convey.So(sec.acceptInSequence(&second, getCloudEvent()), convey.ShouldBeFalse)
+			convey.So(sec.acceptInSequence(&first, getCloudEvent()), convey.ShouldBeTrue)
+		})
+
+		convey.Convey("Given the first dependency is resolved", func() {
+			sensor2.MarkNodePhase(sec.sensor, first.Name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, getCloudEvent(), &sec.log, "event is received")
+
+			convey.Convey("An event of the second dependency must be accepted", func() {
+				convey.So(sec.acceptInSequence(&second, getCloudEvent()), convey.ShouldBeTrue)
+			})
+
+			convey.Convey("An event of the second dependency must be ignored once the sequence has expired", func() {
+				event := getCloudEvent()
+				event.Context.EventTime = metav1.MicroTime{Time: time.Now().UTC().Add(time.Hour)}
+				convey.So(sec.acceptInSequence(&second, event), convey.ShouldBeFalse)
+				node := sensor2.GetNodeByName(sec.sensor, first.Name)
+				convey.So(node.Phase, convey.ShouldEqual, v1alpha1.NodePhaseActive)
+			})
+
+			convey.Convey("Given the reset policy, an out of order event must start the sequence over", func() {
+				sensor2.MarkNodePhase(sec.sensor, first.Name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseActive, nil, &sec.log, "node is active")
+				sensor2.MarkNodePhase(sec.sensor, second.Name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, getCloudEvent(), &sec.log, "event is received")
+				sec.sensor.Spec.Sequence.OutOfOrderPolicy = v1alpha1.OutOfOrderReset
+				sec.sensor.Spec.Dependencies = append(sec.sensor.Spec.Dependencies, v1alpha1.EventDependency{
+					Name: "test-gateway:test3",
+				})
+				sensor2.InitializeNode(sec.sensor, "test-gateway:test3", v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+				third := sec.sensor.Spec.Dependencies[2]
+
+				convey.So(sec.acceptInSequence(&third, getCloudEvent()), convey.ShouldBeFalse)
+				node := sensor2.GetNodeByName(sec.sensor, second.Name)
+				convey.So(node.Phase, convey.ShouldEqual, v1alpha1.NodePhaseActive)
+			})
+		})
+	})
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+const (
+	// shutdownGracePeriod is the time given to the sensor to drain its queue, within the termination grace period of the pod
+	shutdownGracePeriod = 25 * time.Second
+	// shutdownPollInterval is the interval at which the backlog of the queue is checked while draining
+	shutdownPollInterval = 100 * time.Millisecond
+)
+
+// enqueue sends the notification over the internal queue, keeping track of the backlog of the queue
+func (sec *sensorExecutionCtx) enqueue(notification *updateNotification) {
+	atomic.AddInt64(&sec.backlog, 1)
+	sec.queue <- notification
+}
+
+// processQueue processes the update notifications sent over the internal queue
+func (sec *sensorExecutionCtx) processQueue() {
+	for notification := range sec.queue {
+		atomic.StoreInt64(&sec.processingSince, time.Now().UnixNano())
+		sec.processUpdateNotification(notification)
+		atomic.StoreInt64(&sec.processingSince, 0)
+		sec.snapshotState()
+		atomic.AddInt64(&sec.backlog, -1)
+	}
+}
+
+// waitForShutdown blocks until the sensor pod is terminated, then shuts the sensor down.
+// It also returns if the server receiving events stops on its own, as the sensor can't receive events anymore.
+func (sec *sensorExecutionCtx) waitForShutdown(serverStopped <-chan struct{}) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	select {
+	case sig := <-signals:
+		sec.log.Info().Str("signal", sig.String()).Msg("received signal, shutting down sensor")
+		sec.shutdown(shutdownGracePeriod)
+	case <-serverStopped:
+		sec.log.Error().Msg("sensor server stopped, exiting")
+		sec.closeConnections()
+	}
+}
+
+// shutdown stops the intake of events and waits for the queued notifications, and the triggers they run, to be processed.
+// The state of the sensor is persisted after each notification, so the final state is persisted once the queue is drained.
+func (sec *sensorExecutionCtx) shutdown(gracePeriod time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), gracePeriod)
+	defer cancel()
+
+	// the shutdown notification stops the subscriptions and the timers of the sensor
+	shutdown := make(chan struct{})
+	go func() {
+		sec.enqueue(&updateNotification{
+			notificationType: v1alpha1.ShutdownNotification,
+		})
+		close(shutdown)
+	}()
+	select {
+	case <-shutdown:
+	case <-ctx.Done():
+		sec.log.Warn().Msg("grace period elapsed before the intake of events is stopped")
+	}
+
+	// stop accepting events over http and wait for the requests in flight
+	if sec.server != nil {
+		if err := sec.server.Shutdown(ctx); err != nil {
+			sec.log.Warn().Err(err).Msg("failed to shutdown sensor server gracefully")
+		}
+	}
+
+	for atomic.LoadInt64(&sec.backlog) > 0 {
+		select {
+		case <-ctx.Done():
+			sec.log.Warn().Int64("backlog", atomic.LoadInt64(&sec.backlog)).Msg("grace period elapsed before the queue is drained")
+			sec.closeConnections()
+			return
+		case <-time.After(shutdownPollInterval):
+		}
+	}
+	sec.log.Info().Msg("queue is drained")
+	sec.closeConnections()
+}
+
+// stopIntake stops the subscriptions and the timers of the sensor.
+// Events received over http are rejected by the server once it is shut down.
+// Pending debounced triggers are executed and open batches are completed, within the grace period of the shutdown, as their
+// timers don't survive a restart.
+func (sec *sensorExecutionCtx) stopIntake() {
+	atomic.StoreInt32(&sec.shuttingDown, 1)
+	for _, unsubscribe := range sec.nconn.unsubscribers {
+		if err := unsubscribe(); err != nil {
+			sec.log.Warn().Err(err).Msg("failed to stop nats subscription")
+		}
+	}
+	sec.nconn.unsubscribers = nil
+	atomic.StoreInt32(&sec.subscriptions, 0)
+
+	// a debounced trigger whose timer already fired is executed by the notification the timer queued
+	for name, timer := range sec.debounceTimers {
+		if timer.Stop() {
+			sec.log.Info().Str("trigger-name", name).Msg("executing debounced trigger before shutting down")
+			sec.executeDebouncedTrigger(name)
+		}
+	}
+	for name := range sec.batches {
+		sec.log.Info().Str("event-dependency-name", name).Msg("completing batch before shutting down")
+		sec.completeBatch(name)
+	}
+	// absence deadlines have not passed yet, they are armed again once the sensor restarts
+	for _, absence := range sec.absences {
+		absence.timer.Stop()
+	}
+}
+
+// isShuttingDown returns true once the sensor stopped the intake of events
+func (sec *sensorExecutionCtx) isShuttingDown() bool {
+	return atomic.LoadInt32(&sec.shuttingDown) == 1
+}
+
+// closeConnections closes the connections of the sensor and signals that the sensor is shut down
+func (sec *sensorExecutionCtx) closeConnections() {
+	defer close(sec.done)
+	if sec.nconn.standard != nil {
+		sec.nconn.standard.Close()
+	}
+	if sec.nconn.stream != nil {
+		if err := sec.nconn.stream.Close(); err != nil {
+			sec.log.Warn().Err(err).Msg("failed to close nats streaming connection")
+		}
+	}
+	if sec.deadLetterConn != nil {
+		sec.deadLetterConn.Close()
+	}
+}
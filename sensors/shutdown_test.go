@@ -0,0 +1,65 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sensor2 "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestShutdown(t *testing.T) {
+	convey.Convey("Given a sensor processing its queue", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sec := getsensorExecutionCtx(sensor)
+		sec.sensor, err = sec.sensorClient.ArgoprojV1alpha1().Sensors(sensor.Namespace).Create(sensor)
+		convey.So(err, convey.ShouldBeNil)
+
+		sensor2.InitializeNode(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+		sensor2.MarkNodePhase(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseActive, nil, &sec.log, "node is active")
+		sensor2.InitializeNode(sec.sensor, "test-workflow-trigger", v1alpha1.NodeTypeTrigger, &sec.log, "trigger is init")
+
+		sec.queue = make(chan *updateNotification)
+		go sec.processQueue()
+
+		debounced := time.AfterFunc(time.Hour, func() {})
+		sec.debounceTimers["test-workflow-trigger"] = debounced
+
+		convey.Convey("Shutdown must drain the queue and flush the timers", func() {
+			for i := 0; i < 3; i++ {
+				convey.So(sec.sendEventToInternalQueue(getCloudEvent(), nil), convey.ShouldBeTrue)
+			}
+			sec.shutdown(5 * time.Second)
+
+			convey.So(atomic.LoadInt64(&sec.backlog), convey.ShouldEqual, 0)
+			convey.So(sec.isShuttingDown(), convey.ShouldBeTrue)
+			convey.So(debounced.Stop(), convey.ShouldBeFalse)
+			convey.So(sec.debounceTimers, convey.ShouldBeEmpty)
+
+			persisted, err := sec.sensorClient.ArgoprojV1alpha1().Sensors(sensor.Namespace).Get(sensor.Name, metav1.GetOptions{})
+			convey.So(err, convey.ShouldBeNil)
+			node := sensor2.GetNodeByName(persisted, "test-gateway:test")
+			convey.So(node.Event, convey.ShouldNotBeNil)
+		})
+	})
+}
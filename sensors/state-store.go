@@ -0,0 +1,498 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	snats "github.com/nats-io/go-nats-streaming"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// stateStoreLoadTimeout is the time after which a nats state store is considered empty if its last message is not received
+	stateStoreLoadTimeout = 2 * time.Second
+	// configMapStateStoreMaxSize is the maximum size of the events stored in a configmap, below the 1MiB limit of a configmap
+	configMapStateStoreMaxSize = 1000 * 1024
+	// stateStoreEventsKey is the key of the events in the data of a configmap of a configmap state store
+	stateStoreEventsKey = "events"
+	// stateStoreLabel is the label of the configmaps of a configmap state store, whose value is the name of the store
+	stateStoreLabel = "sensors.argoproj.io/state-store"
+)
+
+// nodeEvents are the events of an event dependency node
+type nodeEvents struct {
+	// Event is the latest event of the node
+	Event *apicommon.Event `json:"event,omitempty"`
+	// Events is the current batch of events of the node
+	Events []apicommon.Event `json:"events,omitempty"`
+	// Enrichment is the results of the enrichments of the latest event
+	Enrichment map[string]string `json:"enrichment,omitempty"`
+	// BatchEnrichments is the results of the enrichments of the events of the current batch
+	BatchEnrichments []v1alpha1.EnrichmentResults `json:"batchEnrichments,omitempty"`
+}
+
+// stateStore stores the events of event dependency nodes outside of the sensor resource
+type stateStore interface {
+	// Put stores the events of the node under the key
+	Put(key string, events *nodeEvents) error
+	// Get returns the events stored under the key, nil if there are none
+	Get(key string) (*nodeEvents, error)
+	// Delete removes the events stored under the key
+	Delete(key string) error
+}
+
+// newStateStore returns the state store described by the spec
+func newStateStore(spec *v1alpha1.StateStore, kubeClient kubernetes.Interface, namespace string) (stateStore, error) {
+	switch {
+	case spec.ConfigMap != nil:
+		return &configMapStateStore{
+			client:    kubeClient,
+			namespace: namespace,
+			name:      spec.ConfigMap.Name,
+		}, nil
+	case spec.File != nil:
+		if err := os.MkdirAll(spec.File.Path, 0755); err != nil {
+			return nil, err
+		}
+		return &fileStateStore{
+			path: spec.File.Path,
+		}, nil
+	case spec.Nats != nil:
+		return newNatsStateStore(spec.Nats)
+	default:
+		return nil, fmt.Errorf("state store does not define a backend")
+	}
+}
+
+// configMapStateStore stores the events of each key in its own configmap, named after the store and the key,
+// so the events of all the nodes are not limited by the size of a single configmap
+type configMapStateStore struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+}
+
+// configMap returns the name of the configmap holding the events of the key
+func (s *configMapStateStore) configMap(key string) string {
+	return fmt.Sprintf("%s-%s", s.name, key)
+}
+
+func (s *configMapStateStore) Put(key string, events *nodeEvents) error {
+	value, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	if len(value) > configMapStateStoreMaxSize {
+		return fmt.Errorf("events of %s are %d bytes, more than the %d bytes a configmap can hold", key, len(value), configMapStateStoreMaxSize)
+	}
+	configmaps := s.client.CoreV1().ConfigMaps(s.namespace)
+	cm, err := configmaps.Get(s.configMap(key), metav1.GetOptions{})
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return err
+		}
+		_, err = configmaps.Create(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.configMap(key),
+				Namespace: s.namespace,
+				Labels: map[string]string{
+					stateStoreLabel: s.name,
+				},
+			},
+			Data: map[string]string{
+				stateStoreEventsKey: string(value),
+			},
+		})
+		return err
+	}
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[stateStoreEventsKey] = string(value)
+	_, err = configmaps.Update(cm)
+	return err
+}
+
+func (s *configMapStateStore) Get(key string) (*nodeEvents, error) {
+	cm, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(s.configMap(key), metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	value, ok := cm.Data[stateStoreEventsKey]
+	if !ok {
+		return nil, nil
+	}
+	var events *nodeEvents
+	if err := json.Unmarshal([]byte(value), &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (s *configMapStateStore) Delete(key string) error {
+	err := s.client.CoreV1().ConfigMaps(s.namespace).Delete(s.configMap(key), &metav1.DeleteOptions{})
+	if err != nil && !apierr.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// fileStateStore stores the events in one file per key in a directory
+type fileStateStore struct {
+	path string
+}
+
+func (s *fileStateStore) file(key string) string {
+	return filepath.Join(s.path, fmt.Sprintf("%s.json", key))
+}
+
+func (s *fileStateStore) Put(key string, events *nodeEvents) error {
+	value, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	// write to a temporary file first so that a crash does not leave a partially written file behind
+	tmp := s.file(key) + ".tmp"
+	if err := ioutil.WriteFile(tmp, value, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.file(key))
+}
+
+func (s *fileStateStore) Get(key string) (*nodeEvents, error) {
+	value, err := ioutil.ReadFile(s.file(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var events *nodeEvents
+	if err := json.Unmarshal(value, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (s *fileStateStore) Delete(key string) error {
+	if err := os.Remove(s.file(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// natsStateSnapshot holds the events of all the keys of a nats state store
+type natsStateSnapshot struct {
+	Entries map[string]*nodeEvents `json:"entries"`
+}
+
+// natsStateStore stores the events of all the keys as a snapshot on a nats streaming channel.
+// Every change publishes a new snapshot, so only the last message of the channel is read on start and the older messages
+// can be discarded by the limits of the channel.
+type natsStateStore struct {
+	conn    snats.Conn
+	subject string
+	// entries holds the events of each key
+	entries map[string]*nodeEvents
+}
+
+// newNatsStateStore connects to nats streaming and loads the last snapshot of the channel
+func newNatsStateStore(spec *v1alpha1.NatsStateStore) (*natsStateStore, error) {
+	conn, err := snats.Connect(spec.ClusterId, spec.ClientId, snats.NatsURL(spec.URL))
+	if err != nil {
+		return nil, err
+	}
+	s := &natsStateStore{
+		conn:    conn,
+		subject: spec.Subject,
+		entries: make(map[string]*nodeEvents),
+	}
+
+	received := make(chan []byte, 1)
+	sub, err := conn.Subscribe(spec.Subject, func(msg *snats.Msg) {
+		select {
+		case received <- msg.Data:
+		default:
+		}
+	}, snats.StartWithLastReceived())
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	defer sub.Unsubscribe()
+
+	// the last message is delivered right away, a channel without messages has no snapshot yet
+	select {
+	case data := <-received:
+		var snapshot natsStateSnapshot
+		if err := json.Unmarshal(data, &snapshot); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to parse state snapshot. err: %+v", err)
+		}
+		if snapshot.Entries != nil {
+			s.entries = snapshot.Entries
+		}
+	case <-time.After(stateStoreLoadTimeout):
+	}
+	return s, nil
+}
+
+// publish publishes the snapshot of the entries
+func (s *natsStateStore) publish(entries map[string]*nodeEvents) error {
+	value, err := json.Marshal(&natsStateSnapshot{Entries: entries})
+	if err != nil {
+		return err
+	}
+	return s.conn.Publish(s.subject, value)
+}
+
+func (s *natsStateStore) Put(key string, events *nodeEvents) error {
+	entries := make(map[string]*nodeEvents, len(s.entries)+1)
+	for k, v := range s.entries {
+		entries[k] = v
+	}
+	entries[key] = events
+	if err := s.publish(entries); err != nil {
+		return err
+	}
+	s.entries = entries
+	return nil
+}
+
+func (s *natsStateStore) Get(key string) (*nodeEvents, error) {
+	return s.entries[key], nil
+}
+
+func (s *natsStateStore) Delete(key string) error {
+	if _, ok := s.entries[key]; !ok {
+		return nil
+	}
+	entries := make(map[string]*nodeEvents, len(s.entries))
+	for k, v := range s.entries {
+		if k != key {
+			entries[k] = v
+		}
+	}
+	if err := s.publish(entries); err != nil {
+		return err
+	}
+	s.entries = entries
+	return nil
+}
+
+// escalateStateStoreFailure creates a K8s event to escalate a failure of the state store
+func (sec *sensorExecutionCtx) escalateStateStoreFailure(operation string) {
+	labels := map[string]string{
+		common.LabelEventType:  string(common.EscalationEventType),
+		common.LabelSensorName: sec.sensor.Name,
+		common.LabelOperation:  operation,
+	}
+	if err := common.GenerateK8sEvent(sec.kubeClient, "state store operation failed", common.EscalationEventType, "state store", sec.sensor.Name, sec.sensor.Namespace, sec.controllerInstanceID, sensor.Kind, labels); err != nil {
+		sec.log.Error().Err(err).Msg("failed to create K8s event to escalate state store failure")
+	}
+}
+
+// initStateStore creates the state store of the sensor and restores the events of the event dependency nodes from it,
+// so that partially completed rounds are resumed after a restart
+func (sec *sensorExecutionCtx) initStateStore() {
+	if sec.sensor.Spec.StateStore == nil {
+		return
+	}
+	store, err := newStateStore(sec.sensor.Spec.StateStore, sec.kubeClient, sec.sensor.Namespace)
+	if err != nil {
+		// the events are kept in the sensor resource without a state store
+		sec.log.Error().Err(err).Msg("failed to create state store")
+		sec.escalateStateStoreFailure("state_store_setup")
+		return
+	}
+	sec.store = store
+	sec.restoreNodeEvents()
+	sec.restoreSuspendedEvents()
+}
+
+// restoreNodeEvents restores the events of the event dependency nodes that refer to the state store.
+// Stored events that are not the events of the node are stale and never replace them.
+func (sec *sensorExecutionCtx) restoreNodeEvents() {
+	if sec.store == nil {
+		return
+	}
+	for id, node := range sec.sensor.Status.Nodes {
+		if node.Type != v1alpha1.NodeTypeEventDependency || node.EventRef == "" {
+			continue
+		}
+		events, ok := sec.storedEvents[node.EventRef]
+		if !ok {
+			var err error
+			if events, err = sec.store.Get(node.EventRef); err != nil {
+				sec.log.Error().Err(err).Str("node-name", node.Name).Msg("failed to restore events of node from state store")
+				sec.escalateStateStoreFailure("state_store_restore")
+				continue
+			}
+			if events == nil {
+				sec.log.Warn().Str("node-name", node.Name).Msg("events of node are missing in state store")
+				continue
+			}
+			sec.storedEvents[node.EventRef] = events
+		}
+		if !events.matches(&node) {
+			sec.log.Error().Str("node-name", node.Name).Msg("stored events of node are stale, keeping the events of the node")
+			sec.escalateStateStoreFailure("state_store_restore")
+			delete(sec.storedEvents, node.EventRef)
+			node.EventRef = ""
+			sec.sensor.Status.Nodes[id] = node
+			continue
+		}
+		restored := events.DeepCopy()
+		node.Event = restored.Event
+		node.Events = restored.Events
+		node.Enrichment = restored.Enrichment
+		node.BatchEnrichments = restored.BatchEnrichments
+		sec.sensor.Status.Nodes[id] = node
+	}
+}
+
+// storeKey returns the key of the events of the node in the state store.
+// The replicas of a scaled out sensor have the same nodes, so each replica stores the events of its partition under its own keys.
+func (sec *sensorExecutionCtx) storeKey(nodeID string) string {
+	if !sec.isScaledOut() {
+		return nodeID
+	}
+	return common.DefaultSensorReplicaName(nodeID, sec.replica)
+}
+
+// persistableSensor stores the changed events of the event dependency nodes in the state store and
+// returns a copy of the sensor whose nodes refer to the stored events instead of holding their payloads
+func (sec *sensorExecutionCtx) persistableSensor() *v1alpha1.Sensor {
+	if sec.store == nil {
+		return sec.sensor
+	}
+	persistable := sec.sensor.DeepCopy()
+	for id, node := range persistable.Status.Nodes {
+		if node.Type != v1alpha1.NodeTypeEventDependency {
+			continue
+		}
+		if node.Event == nil && len(node.Events) == 0 {
+			if node.EventRef != "" {
+				if err := sec.store.Delete(node.EventRef); err != nil {
+					sec.log.Error().Err(err).Str("node-name", node.Name).Msg("failed to delete events of node from state store")
+					sec.escalateStateStoreFailure("state_store_delete")
+					continue
+				}
+				delete(sec.storedEvents, node.EventRef)
+				node.EventRef = ""
+				persistable.Status.Nodes[id] = node
+			}
+			continue
+		}
+
+		events := &nodeEvents{
+			Event:            node.Event,
+			Events:           node.Events,
+			Enrichment:       node.Enrichment,
+			BatchEnrichments: node.BatchEnrichments,
+		}
+		key := sec.storeKey(node.ID)
+		if stored, ok := sec.storedEvents[key]; !ok || !reflect.DeepEqual(stored, events) {
+			if err := sec.store.Put(key, events); err != nil {
+				// the events are kept in the sensor resource until they are stored. The node no longer refers to the
+				// previously stored events, so they can't replace its events once restored
+				sec.log.Error().Err(err).Str("node-name", node.Name).Msg("failed to store events of node in state store")
+				sec.escalateStateStoreFailure("state_store_put")
+				delete(sec.storedEvents, key)
+				if err := sec.store.Delete(key); err != nil {
+					sec.log.Error().Err(err).Str("node-name", node.Name).Msg("failed to delete stale events of node from state store")
+				}
+				node.EventRef = ""
+				persistable.Status.Nodes[id] = node
+				continue
+			}
+			sec.storedEvents[key] = events.DeepCopy()
+		}
+
+		// only the context of the events is kept in the status
+		node.EventRef = key
+		node.Enrichment = nil
+		node.BatchEnrichments = nil
+		if node.Event != nil {
+			node.Event.Payload = nil
+		}
+		for i := range node.Events {
+			node.Events[i].Payload = nil
+		}
+		persistable.Status.Nodes[id] = node
+	}
+	return persistable
+}
+
+// matches returns true if the stored events are the events of the node, whose status only keeps their context
+func (in *nodeEvents) matches(node *v1alpha1.NodeStatus) bool {
+	if (in.Event == nil) != (node.Event == nil) || len(in.Events) != len(node.Events) {
+		return false
+	}
+	if in.Event != nil && in.Event.Context.EventID != node.Event.Context.EventID {
+		return false
+	}
+	for i := range in.Events {
+		if in.Events[i].Context.EventID != node.Events[i].Context.EventID {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the node events
+func (in *nodeEvents) DeepCopy() *nodeEvents {
+	out := &nodeEvents{
+		Event: in.Event.DeepCopy(),
+	}
+	if in.Events != nil {
+		out.Events = make([]apicommon.Event, len(in.Events))
+		for i := range in.Events {
+			in.Events[i].DeepCopyInto(&out.Events[i])
+		}
+	}
+	if in.Enrichment != nil {
+		out.Enrichment = make(map[string]string, len(in.Enrichment))
+		for key, value := range in.Enrichment {
+			out.Enrichment[key] = value
+		}
+	}
+	if in.BatchEnrichments != nil {
+		out.BatchEnrichments = make([]v1alpha1.EnrichmentResults, len(in.BatchEnrichments))
+		for i := range in.BatchEnrichments {
+			in.BatchEnrichments[i].DeepCopyInto(&out.BatchEnrichments[i])
+		}
+	}
+	return out
+}
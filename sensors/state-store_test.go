@@ -0,0 +1,161 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sensor2 "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStateStore(t *testing.T) {
+	convey.Convey("Given a sensor", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sec := getsensorExecutionCtx(sensor)
+
+		dir, err := ioutil.TempDir("", "sensor-state")
+		convey.So(err, convey.ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		stores := map[string]*v1alpha1.StateStore{
+			"file": {
+				File: &v1alpha1.FileStateStore{
+					Path: dir,
+				},
+			},
+			"configmap": {
+				ConfigMap: &v1alpha1.ConfigMapStateStore{
+					Name: "sensor-state",
+				},
+			},
+		}
+		for name, spec := range stores {
+			convey.Convey("Events must round trip through the "+name+" state store", func() {
+				store, err := newStateStore(spec, sec.kubeClient, sensor.Namespace)
+				convey.So(err, convey.ShouldBeNil)
+
+				events, err := store.Get("node")
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(events, convey.ShouldBeNil)
+
+				convey.So(store.Put("node", &nodeEvents{Event: getCloudEvent()}), convey.ShouldBeNil)
+				events, err = store.Get("node")
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(events, convey.ShouldNotBeNil)
+				convey.So(string(events.Event.Payload), convey.ShouldEqual, string(getCloudEvent().Payload))
+
+				convey.So(store.Delete("node"), convey.ShouldBeNil)
+				events, err = store.Get("node")
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(events, convey.ShouldBeNil)
+			})
+		}
+
+		convey.Convey("Events must be stored in a configmap per key, up to the size of a configmap", func() {
+			store, err := newStateStore(stores["configmap"], sec.kubeClient, sensor.Namespace)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(store.Put("node", &nodeEvents{Event: getCloudEvent()}), convey.ShouldBeNil)
+			_, err = sec.kubeClient.CoreV1().ConfigMaps(sensor.Namespace).Get("sensor-state-node", metav1.GetOptions{})
+			convey.So(err, convey.ShouldBeNil)
+
+			large := getCloudEvent()
+			large.Payload = make([]byte, configMapStateStoreMaxSize)
+			convey.So(store.Put("large-node", &nodeEvents{Event: large}), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("Events that fail to be stored must be kept in the sensor and never be replaced by stale stored events", func() {
+			sec.sensor.Spec.StateStore = stores["configmap"]
+			sec.initStateStore()
+			convey.So(sec.store, convey.ShouldNotBeNil)
+
+			sensor2.InitializeNode(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+			sensor2.MarkNodePhase(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, getCloudEvent(), &sec.log, "event is received")
+			sec.sensor = sec.persistableSensor()
+			sec.restoreNodeEvents()
+			convey.So(sensor2.GetNodeByName(sec.sensor, "test-gateway:test").EventRef, convey.ShouldNotBeEmpty)
+
+			large := getCloudEvent()
+			large.Context.EventID = "large"
+			large.Payload = make([]byte, configMapStateStoreMaxSize)
+			sensor2.MarkNodePhase(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, large, &sec.log, "event is received")
+			persistable := sec.persistableSensor()
+			node := sensor2.GetNodeByName(persistable, "test-gateway:test")
+			convey.So(node.EventRef, convey.ShouldBeEmpty)
+			convey.So(len(node.Event.Payload), convey.ShouldEqual, configMapStateStoreMaxSize)
+
+			restarted := getsensorExecutionCtx(persistable)
+			restarted.initStateStore()
+			node = sensor2.GetNodeByName(restarted.sensor, "test-gateway:test")
+			convey.So(node.Event.Context.EventID, convey.ShouldEqual, "large")
+			convey.So(len(node.Event.Payload), convey.ShouldEqual, configMapStateStoreMaxSize)
+
+			convey.Convey("Stored events of another event must not be restored", func() {
+				node.EventRef = node.ID
+				node.Event.Payload = nil
+				restarted.sensor.Status.Nodes[node.ID] = *node
+				convey.So(restarted.store.Put(node.ID, &nodeEvents{Event: getCloudEvent()}), convey.ShouldBeNil)
+				restarted.restoreNodeEvents()
+				node := sensor2.GetNodeByName(restarted.sensor, "test-gateway:test")
+				convey.So(node.EventRef, convey.ShouldBeEmpty)
+				convey.So(node.Event.Context.EventID, convey.ShouldEqual, "large")
+			})
+		})
+
+		convey.Convey("Payloads and results of enrichments must be kept out of the persisted sensor", func() {
+			sec.sensor.Spec.StateStore = stores["file"]
+			sec.initStateStore()
+			convey.So(sec.store, convey.ShouldNotBeNil)
+
+			sensor2.InitializeNode(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+			sensor2.MarkNodePhase(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, getCloudEvent(), &sec.log, "event is received")
+			sec.recordEnrichment("test-gateway:test", map[string]string{"team": `"payments"`})
+
+			persistable := sec.persistableSensor()
+			node := sensor2.GetNodeByName(persistable, "test-gateway:test")
+			convey.So(node.EventRef, convey.ShouldEqual, node.ID)
+			convey.So(node.Event, convey.ShouldNotBeNil)
+			convey.So(node.Event.Payload, convey.ShouldBeNil)
+			convey.So(node.Enrichment, convey.ShouldBeNil)
+			convey.So(sensor2.GetNodeByName(sec.sensor, "test-gateway:test").Event.Payload, convey.ShouldNotBeNil)
+
+			convey.Convey("Payloads must be restored from the state store after a restart", func() {
+				restarted := getsensorExecutionCtx(persistable)
+				restarted.initStateStore()
+				node := sensor2.GetNodeByName(restarted.sensor, "test-gateway:test")
+				convey.So(string(node.Event.Payload), convey.ShouldEqual, string(getCloudEvent().Payload))
+				convey.So(node.Enrichment["team"], convey.ShouldEqual, `"payments"`)
+			})
+
+			convey.Convey("Stored events must be deleted once the node has no events", func() {
+				node.Event = nil
+				persistable.Status.Nodes[node.ID] = *node
+				sec.sensor = persistable
+				node := sensor2.GetNodeByName(sec.persistableSensor(), "test-gateway:test")
+				convey.So(node.EventRef, convey.ShouldBeEmpty)
+				events, err := sec.store.Get(node.ID)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(events, convey.ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"fmt"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// suspendedEventsKey is the key of the events buffered while the sensor is suspended in the state store
+const suspendedEventsKey = "suspended-events"
+
+// suspendEvent buffers or drops an event received while the sensor is suspended, depending upon the suspend policy.
+// An event acknowledged once processed is only buffered in the state store, so a buffered event is never lost once acknowledged.
+// Without a state store, such an event is not acknowledged and the returned error leaves it to be delivered again.
+func (sec *sensorExecutionCtx) suspendEvent(ew *updateNotification) error {
+	policy := sec.sensor.Spec.SuspendPolicy
+	if policy == nil || policy.Action != v1alpha1.SuspendActionBuffer {
+		sec.log.Warn().Str("event-dependency-name", ew.event.Context.Source.Host).Msg("sensor is suspended, dropping event")
+		return nil
+	}
+
+	limit := int(policy.BufferLimit)
+	if limit <= 0 {
+		limit = common.DefaultSuspendBufferLimit
+	}
+	if len(sec.suspendedEvents) >= limit {
+		sec.log.Warn().Str("event-dependency-name", ew.event.Context.Source.Host).Int("buffer-limit", limit).Msg("suspended events buffer is full, dropping event")
+		return nil
+	}
+
+	if sec.store == nil && ew.processed != nil {
+		sec.log.Warn().Str("event-dependency-name", ew.event.Context.Source.Host).Msg("sensor is suspended and has no state store to buffer the event in, not acknowledging event")
+		return fmt.Errorf("sensor is suspended and has no state store to buffer the event in")
+	}
+	sec.suspendedEvents = append(sec.suspendedEvents, ew)
+	if err := sec.persistSuspendedEvents(); err != nil {
+		sec.suspendedEvents = sec.suspendedEvents[:len(sec.suspendedEvents)-1]
+		sec.log.Error().Err(err).Str("event-dependency-name", ew.event.Context.Source.Host).Msg("failed to buffer event in state store")
+		sec.escalateStateStoreFailure("state_store_buffer")
+		return err
+	}
+
+	// the response is sent, and the event acknowledged, once the event is buffered rather than when the buffered event is processed
+	ew.writer = nil
+	ew.processed = nil
+	sec.log.Info().Str("event-dependency-name", ew.event.Context.Source.Host).Int("buffered-events", len(sec.suspendedEvents)).Msg("sensor is suspended, event is buffered")
+	return nil
+}
+
+// persistSuspendedEvents stores the buffered events in the state store, or removes them once there are none. The events are kept
+// in memory only if the sensor has no state store.
+func (sec *sensorExecutionCtx) persistSuspendedEvents() error {
+	if sec.store == nil {
+		return nil
+	}
+	key := sec.storeKey(suspendedEventsKey)
+	if len(sec.suspendedEvents) == 0 {
+		return sec.store.Delete(key)
+	}
+	events := &nodeEvents{
+		Events: make([]apicommon.Event, 0, len(sec.suspendedEvents)),
+	}
+	for _, ew := range sec.suspendedEvents {
+		events.Events = append(events.Events, *ew.event)
+	}
+	return sec.store.Put(key, events)
+}
+
+// restoreSuspendedEvents restores the events buffered in the state store before the sensor restarted
+func (sec *sensorExecutionCtx) restoreSuspendedEvents() {
+	events, err := sec.store.Get(sec.storeKey(suspendedEventsKey))
+	if err != nil {
+		sec.log.Error().Err(err).Msg("failed to restore buffered events from state store")
+		sec.escalateStateStoreFailure("state_store_restore")
+		return
+	}
+	if events == nil {
+		return
+	}
+	for i := range events.Events {
+		event := events.Events[i]
+		eventDependency, ok := sec.validateEvent(&event)
+		if !ok {
+			sec.log.Warn().Str("event-dependency-name", event.Context.Source.Host).Msg("buffered event no longer belongs to an event dependency, dropping it")
+			continue
+		}
+		sec.suspendedEvents = append(sec.suspendedEvents, &updateNotification{
+			event:            &event,
+			eventDependency:  eventDependency,
+			notificationType: v1alpha1.EventNotification,
+		})
+	}
+	sec.log.Info().Int("buffered-events", len(sec.suspendedEvents)).Msg("restored buffered events from state store")
+}
+
+// resumeSensor processes the events buffered while the sensor was suspended in the order they were received
+func (sec *sensorExecutionCtx) resumeSensor() {
+	events := sec.suspendedEvents
+	sec.suspendedEvents = nil
+	sec.log.Info().Int("buffered-events", len(events)).Msg("sensor is resumed, processing buffered events")
+	for _, ew := range events {
+		sec.processUpdateNotification(ew)
+	}
+	if len(events) > 0 {
+		if err := sec.persistSuspendedEvents(); err != nil {
+			sec.log.Error().Err(err).Msg("failed to remove buffered events from state store")
+			sec.escalateStateStoreFailure("state_store_delete")
+		}
+	}
+
+	// event dependencies resolved while the sensor was suspended, e.g. by absence deadlines or batch windows, complete a round
+	if sec.sensor.AreAllNodesSuccess(v1alpha1.NodeTypeEventDependency) {
+		sec.processTriggers()
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sensor2 "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestSuspendSensor(t *testing.T) {
+	convey.Convey("Given a suspended sensor", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sensor.Spec.Suspend = true
+		sensor.Spec.SuspendPolicy = &v1alpha1.SuspendPolicy{
+			Action:      v1alpha1.SuspendActionBuffer,
+			BufferLimit: 1,
+		}
+		sec := getsensorExecutionCtx(sensor)
+		sec.sensor, err = sec.sensorClient.ArgoprojV1alpha1().Sensors(sensor.Namespace).Create(sensor)
+		convey.So(err, convey.ShouldBeNil)
+
+		sensor2.InitializeNode(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, &sec.log, "node is init")
+		sensor2.MarkNodePhase(sec.sensor, "test-gateway:test", v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseActive, nil, &sec.log, "node is active")
+		sensor2.InitializeNode(sec.sensor, "test-workflow-trigger", v1alpha1.NodeTypeTrigger, &sec.log, "trigger is init")
+
+		for i := 0; i < 2; i++ {
+			sec.processUpdateNotification(&updateNotification{
+				event:            getCloudEvent(),
+				notificationType: v1alpha1.EventNotification,
+				writer:           &mockHttpWriter{},
+				eventDependency: &v1alpha1.EventDependency{
+					Name: "test-gateway:test",
+				},
+			})
+		}
+
+		convey.Convey("Events must be buffered up to the limit and triggers must not be executed", func() {
+			convey.So(len(sec.suspendedEvents), convey.ShouldEqual, 1)
+			convey.So(sec.sensor.Status.CompletionCount, convey.ShouldEqual, 0)
+		})
+
+		convey.Convey("An event acknowledged once processed must not be buffered without a state store", func() {
+			sec.suspendedEvents = nil
+			processed := make(chan error, 1)
+			sec.processUpdateNotification(&updateNotification{
+				event:            getCloudEvent(),
+				notificationType: v1alpha1.EventNotification,
+				eventDependency: &v1alpha1.EventDependency{
+					Name: "test-gateway:test",
+				},
+				processed: processed,
+			})
+			convey.So(<-processed, convey.ShouldNotBeNil)
+			convey.So(sec.suspendedEvents, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("Buffered events must be restored from the state store after a restart", func() {
+			dir, err := ioutil.TempDir("", "sensor-state")
+			convey.So(err, convey.ShouldBeNil)
+			defer os.RemoveAll(dir)
+			sec.sensor.Spec.StateStore = &v1alpha1.StateStore{
+				File: &v1alpha1.FileStateStore{
+					Path: dir,
+				},
+			}
+			sec.initStateStore()
+			convey.So(sec.persistSuspendedEvents(), convey.ShouldBeNil)
+
+			restarted := getsensorExecutionCtx(sec.sensor.DeepCopy())
+			restarted.initStateStore()
+			convey.So(len(restarted.suspendedEvents), convey.ShouldEqual, 1)
+			convey.So(restarted.suspendedEvents[0].eventDependency.Name, convey.ShouldEqual, "test-gateway:test")
+		})
+
+		convey.Convey("Resume the sensor and process buffered events", func() {
+			resumed := sec.sensor.DeepCopy()
+			resumed.Spec.Suspend = false
+			sec.processUpdateNotification(&updateNotification{
+				notificationType: v1alpha1.ResourceUpdateNotification,
+				sensor:           resumed,
+			})
+			convey.So(len(sec.suspendedEvents), convey.ShouldEqual, 0)
+			convey.So(sec.sensor.Status.CompletionCount, convey.ShouldEqual, 1)
+		})
+	})
+}
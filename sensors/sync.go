@@ -39,10 +39,10 @@ func (se *sensorExecutionCtx) syncSensor(ctx context.Context) cache.Controller {
 		cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(old, new interface{}) {
 				if newSensor, ok := new.(*v1alpha1.Sensor); ok {
-					se.queue <- &updateNotification{
+					se.enqueue(&updateNotification{
 						sensor:           newSensor,
 						notificationType: v1alpha1.ResourceUpdateNotification,
-					}
+					})
 				}
 			},
 		})
@@ -0,0 +1,130 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/tidwall/gjson"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// fanOutTrigger creates the resource object of the trigger once per element of the fan-out array.
+// The parameters that refer to the fan-out event dependency resolve against the element.
+func (sec *sensorExecutionCtx) fanOutTrigger(trigger v1alpha1.Trigger, obj *unstructured.Unstructured, events map[string]apicommon.Event) error {
+	fanOut := trigger.FanOut
+
+	event, ok := events[fanOut.Event]
+	if !ok {
+		// the fan-out event dependency is not necessarily referred to by a parameter
+		extracted := sec.extractEvents([]v1alpha1.ResourceParameter{
+			{
+				Src: &v1alpha1.ResourceParameterSource{Event: fanOut.Event},
+			},
+		})
+		if event, ok = extracted[fanOut.Event]; !ok {
+			return fmt.Errorf("event dependency %s has no event to fan out over", fanOut.Event)
+		}
+	}
+
+	elements, err := fanOutElements(&event, fanOut.Path)
+	if err != nil {
+		return err
+	}
+
+	maxItems := common.DefaultFanOutMaxItems
+	if fanOut.MaxItems > 0 {
+		maxItems = int(fanOut.MaxItems)
+	}
+	if len(elements) > maxItems {
+		sec.log.Warn().Str("trigger-name", trigger.Name).Int("items", len(elements)).Int("max-items", maxItems).Msg("fan-out array exceeds max items, dropping remaining items")
+		elements = elements[:maxItems]
+	}
+
+	parallelism := 1
+	if fanOut.Parallelism > 0 {
+		parallelism = int(fanOut.Parallelism)
+	}
+
+	sec.log.Info().Str("trigger-name", trigger.Name).Int("items", len(elements)).Int("parallelism", parallelism).Msg("fanning out trigger")
+
+	errs := make([]error, len(elements))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i := range elements {
+		elementEvents := make(map[string]apicommon.Event, len(events))
+		for name, e := range events {
+			elementEvents[name] = e
+		}
+		elementEvents[fanOut.Event] = elements[i]
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, elementEvents map[string]apicommon.Event) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = sec.createResourceObject(trigger.Resource, obj.DeepCopy(), elementEvents)
+		}(i, elementEvents)
+	}
+	wg.Wait()
+
+	var failed int
+	var firstErr error
+	for _, err := range errs {
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d fan-out executions failed. err: %+v", failed, len(elements), firstErr)
+	}
+	return nil
+}
+
+// fanOutElements splits the array at the path of the event data into one event per element.
+// The payload of each event is the JSON encoded element.
+func fanOutElements(event *apicommon.Event, path string) ([]apicommon.Event, error) {
+	js, err := common.RenderEventDataAsJSON(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render event payload as JSON. err: %+v", err)
+	}
+	res := gjson.ParseBytes(js)
+	if path != "" {
+		res = gjson.GetBytes(js, path)
+	}
+	if !res.IsArray() {
+		return nil, fmt.Errorf("value at path '%s' is not an array", path)
+	}
+
+	var elements []apicommon.Event
+	for _, element := range res.Array() {
+		elementEvent := apicommon.Event{
+			Context: *event.Context.DeepCopy(),
+			Payload: []byte(element.Raw),
+		}
+		elementEvent.Context.ContentType = common.MediaTypeJSON
+		elements = append(elements, elementEvent)
+	}
+	return elements, nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_fanOutElements(t *testing.T) {
+	event := getCloudEvent()
+	event.Payload = []byte(`{"commits":[{"id":"a"},{"id":"b"}],"ref":"master"}`)
+
+	elements, err := fanOutElements(event, "commits")
+	if err != nil {
+		t.Fatalf("fanOutElements() error = %v", err)
+	}
+	if len(elements) != 2 || string(elements[1].Payload) != `{"id":"b"}` {
+		t.Errorf("fanOutElements() = %+v", elements)
+	}
+	if elements[0].Context.EventID != event.Context.EventID {
+		t.Errorf("fanOutElements() context = %+v", elements[0].Context)
+	}
+
+	if _, err := fanOutElements(event, "ref"); err == nil {
+		t.Errorf("fanOutElements() expected error for a non array value")
+	}
+
+	event.Payload = []byte(`[1,2,3]`)
+	if elements, err := fanOutElements(event, ""); err != nil || len(elements) != 3 {
+		t.Errorf("fanOutElements() = %+v, %v", elements, err)
+	}
+}
+
+func TestFanOutTrigger(t *testing.T) {
+	convey.Convey("Given a trigger that fans out over an event dependency without event", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sec := getsensorExecutionCtx(sensor)
+		trigger := testTrigger
+		trigger.FanOut = &v1alpha1.FanOut{
+			Event: "test-gateway:test",
+			Path:  "commits",
+		}
+
+		convey.Convey("Fanning out must fail", func() {
+			err := sec.fanOutTrigger(trigger, &unstructured.Unstructured{}, nil)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
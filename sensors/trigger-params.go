@@ -19,6 +19,7 @@ package sensors
 import (
 	"fmt"
 
+	"github.com/argoproj/argo-events/common"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/tidwall/gjson"
@@ -51,7 +52,7 @@ func resolveParamValue(src *v1alpha1.ResourceParameterSource, events map[string]
 		if src.Path == "" {
 			return string(e.Payload), nil
 		}
-		js, err := renderEventDataAsJSON(&e)
+		js, err := common.RenderEventDataAsJSON(&e)
 		if err != nil {
 			fmt.Printf("failed to render event data as json. err: %+v", err)
 			if src.Value != nil {
@@ -22,6 +22,7 @@ import (
 
 	"fmt"
 
+	"github.com/argoproj/argo-events/common"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
@@ -31,13 +32,13 @@ func Test_applyParams(t *testing.T) {
 	events := map[string]apicommon.Event{
 		"simpleJSON": {
 			Context: apicommon.EventContext{
-				ContentType: MediaTypeJSON,
+				ContentType: common.MediaTypeJSON,
 			},
 			Payload: []byte(`{"name":{"first":"matt","last":"magaldi"},"age":24}`),
 		},
 		"nonJSON": {
 			Context: apicommon.EventContext{
-				ContentType: MediaTypeJSON,
+				ContentType: common.MediaTypeJSON,
 			},
 			Payload: []byte(`apiVersion: v1alpha1`),
 		},
@@ -0,0 +1,123 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"fmt"
+	"time"
+
+	sn "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"golang.org/x/time/rate"
+)
+
+// triggerLimiter is the token bucket that limits the rate of executions of a trigger
+type triggerLimiter struct {
+	// spec is the rate limit the limiter is built from
+	spec v1alpha1.RateLimit
+	// limiter is the token bucket
+	limiter *rate.Limiter
+}
+
+// newRateLimiter returns a token bucket for the rate limit
+func newRateLimiter(rateLimit *v1alpha1.RateLimit) *rate.Limiter {
+	unit := time.Second
+	switch rateLimit.Unit {
+	case v1alpha1.RateLimitUnitMinute:
+		unit = time.Minute
+	case v1alpha1.RateLimitUnitHour:
+		unit = time.Hour
+	}
+	burst := int(rateLimit.Burst)
+	if burst <= 0 {
+		burst = 1
+	}
+	return rate.NewLimiter(rate.Limit(float64(rateLimit.RequestsPerUnit)/unit.Seconds()), burst)
+}
+
+// allowTrigger checks whether the rate limit of the trigger, if any, allows the trigger to be executed now
+func (sec *sensorExecutionCtx) allowTrigger(trigger v1alpha1.Trigger) bool {
+	if trigger.RateLimit == nil {
+		delete(sec.triggerLimiters, trigger.Name)
+		return true
+	}
+	tl, ok := sec.triggerLimiters[trigger.Name]
+	// rebuild the token bucket if the rate limit of the trigger has been updated
+	if !ok || tl.spec != *trigger.RateLimit {
+		tl = &triggerLimiter{
+			spec:    *trigger.RateLimit,
+			limiter: newRateLimiter(trigger.RateLimit),
+		}
+		sec.triggerLimiters[trigger.Name] = tl
+	}
+	return tl.limiter.Allow()
+}
+
+// suppressTrigger records a suppressed execution in the trigger node
+func (sec *sensorExecutionCtx) suppressTrigger(triggerName string, message string) {
+	node := sn.GetNodeByName(sec.sensor, triggerName)
+	if node == nil {
+		sec.log.Warn().Str("trigger-name", triggerName).Msg("trigger node does not exist, cannot record suppressed execution")
+		return
+	}
+	node.SuppressedCount = node.SuppressedCount + 1
+	node.Message = message
+	sec.sensor.Status.Nodes[node.ID] = *node
+	sec.log.Info().Str("trigger-name", triggerName).Int32("suppressed-count", node.SuppressedCount).Msg(message)
+}
+
+// debounceTrigger schedules the execution of the trigger after the quiet period.
+// A pending execution is coalesced into the newly scheduled one and is counted as suppressed.
+func (sec *sensorExecutionCtx) debounceTrigger(trigger v1alpha1.Trigger) {
+	period, err := time.ParseDuration(trigger.Debounce.Period)
+	if err != nil {
+		sec.log.Error().Err(err).Str("trigger-name", trigger.Name).Msg("failed to parse debounce period")
+		sn.MarkNodePhase(sec.sensor, trigger.Name, v1alpha1.NodeTypeTrigger, v1alpha1.NodePhaseError, nil, &sec.log, fmt.Sprintf("failed to parse debounce period. err: %+v", err))
+		return
+	}
+
+	if timer, ok := sec.debounceTimers[trigger.Name]; ok && timer.Stop() {
+		sec.suppressTrigger(trigger.Name, "trigger execution is coalesced into a newer execution")
+	}
+
+	triggerName := trigger.Name
+	sec.debounceTimers[triggerName] = time.AfterFunc(period, func() {
+		sec.enqueue(&updateNotification{
+			notificationType: v1alpha1.DebouncedTriggerNotification,
+			trigger:          triggerName,
+		})
+	})
+	sec.log.Info().Str("trigger-name", triggerName).Str("period", trigger.Debounce.Period).Msg("trigger execution is debounced")
+}
+
+// executeDebouncedTrigger executes a debounced trigger once its quiet period has elapsed
+func (sec *sensorExecutionCtx) executeDebouncedTrigger(triggerName string) {
+	delete(sec.debounceTimers, triggerName)
+
+	if sec.sensor.Spec.Suspend {
+		sec.suppressTrigger(triggerName, "trigger execution is suppressed as sensor is suspended")
+		return
+	}
+
+	for _, trigger := range sec.sensor.Spec.Triggers {
+		if trigger.Name == triggerName {
+			sec.fireTrigger(trigger)
+			return
+		}
+	}
+	sec.log.Warn().Str("trigger-name", triggerName).Msg("debounced trigger no longer exists")
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"testing"
+
+	sensor2 "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestTriggerThrottle(t *testing.T) {
+	convey.Convey("Given a sensor with a rate limited trigger", t, func() {
+		sensor, err := getSensor()
+		convey.So(err, convey.ShouldBeNil)
+		sec := getsensorExecutionCtx(sensor)
+		sensor2.InitializeNode(sec.sensor, "test-workflow-trigger", v1alpha1.NodeTypeTrigger, &sec.log, "trigger is init")
+
+		trigger := sec.sensor.Spec.Triggers[0]
+		trigger.RateLimit = &v1alpha1.RateLimit{
+			Unit:            v1alpha1.RateLimitUnitHour,
+			RequestsPerUnit: 1,
+			Burst:           2,
+		}
+
+		convey.Convey("Executions beyond the burst must be suppressed", func() {
+			convey.So(sec.allowTrigger(trigger), convey.ShouldBeTrue)
+			convey.So(sec.allowTrigger(trigger), convey.ShouldBeTrue)
+			convey.So(sec.allowTrigger(trigger), convey.ShouldBeFalse)
+		})
+
+		convey.Convey("Updating the rate limit must rebuild the token bucket", func() {
+			convey.So(sec.allowTrigger(trigger), convey.ShouldBeTrue)
+			convey.So(sec.allowTrigger(trigger), convey.ShouldBeTrue)
+			trigger.RateLimit = &v1alpha1.RateLimit{
+				Unit:            v1alpha1.RateLimitUnitHour,
+				RequestsPerUnit: 1,
+				Burst:           1,
+			}
+			convey.So(sec.allowTrigger(trigger), convey.ShouldBeTrue)
+		})
+
+		convey.Convey("Suppressed executions must be counted in the trigger node", func() {
+			sec.fireTrigger(trigger)
+			sec.fireTrigger(trigger)
+			sec.fireTrigger(trigger)
+			node := sensor2.GetNodeByName(sec.sensor, trigger.Name)
+			convey.So(node.SuppressedCount, convey.ShouldEqual, 1)
+		})
+
+		convey.Convey("Debounced executions must be coalesced", func() {
+			trigger.RateLimit = nil
+			trigger.Debounce = &v1alpha1.Debounce{
+				Period: "1h",
+			}
+			sec.debounceTrigger(trigger)
+			sec.debounceTrigger(trigger)
+			node := sensor2.GetNodeByName(sec.sensor, trigger.Name)
+			convey.So(node.SuppressedCount, convey.ShouldEqual, 1)
+			convey.So(sec.debounceTimers[trigger.Name].Stop(), convey.ShouldBeTrue)
+		})
+	})
+}
@@ -32,6 +32,12 @@ import (
 
 // processTriggers checks if all event dependencies are complete and then starts executing triggers
 func (sec *sensorExecutionCtx) processTriggers() {
+	// triggers of a suspended sensor are executed once the sensor is resumed
+	if sec.sensor.Spec.Suspend {
+		sec.log.Info().Msg("triggers can't be executed because sensor is suspended")
+		return
+	}
+
 	// to trigger the sensor action/s we need to check if all event dependencies are completed and sensor is active
 	if sec.sensor.AreAllNodesSuccess(v1alpha1.NodeTypeEventDependency) {
 		sec.log.Info().Msg("all event dependencies are marked completed, processing triggers")
@@ -43,28 +49,12 @@ func (sec *sensorExecutionCtx) processTriggers() {
 		}
 
 		for _, trigger := range sec.sensor.Spec.Triggers {
-			if err := sec.executeTrigger(trigger); err != nil {
-				sec.log.Error().Str("trigger-name", trigger.Name).Err(err).Msg("trigger failed to execute")
-
-				sn.MarkNodePhase(sec.sensor, trigger.Name, v1alpha1.NodeTypeTrigger, v1alpha1.NodePhaseError, nil, &sec.log, fmt.Sprintf("failed to execute trigger. err: %+v", err))
-
-				// escalate using K8s event
-				labels[common.LabelEventType] = string(common.EscalationEventType)
-				if err := common.GenerateK8sEvent(sec.kubeClient, fmt.Sprintf("failed to execute trigger %s", trigger.Name), common.EscalationEventType,
-					"trigger failure", sec.sensor.Name, sec.sensor.Namespace, sec.controllerInstanceID, sensor.Kind, labels); err != nil {
-					sec.log.Error().Err(err).Msg("failed to create K8s event to escalate trigger failure")
-				}
+			// debounced triggers are executed once their quiet period has elapsed
+			if trigger.Debounce != nil {
+				sec.debounceTrigger(trigger)
 				continue
 			}
-
-			// mark trigger as complete.
-			sn.MarkNodePhase(sec.sensor, trigger.Name, v1alpha1.NodeTypeTrigger, v1alpha1.NodePhaseComplete, nil, &sec.log, "successfully executed trigger")
-
-			labels[common.LabelEventType] = string(common.OperationSuccessEventType)
-			if err := common.GenerateK8sEvent(sec.kubeClient, fmt.Sprintf("trigger %s executed successfully", trigger.Name), common.OperationSuccessEventType,
-				"trigger executed", sec.sensor.Name, sec.sensor.Namespace, sec.controllerInstanceID, sensor.Kind, labels); err != nil {
-				sec.log.Error().Err(err).Msg("failed to create K8s event to log trigger execution")
-			}
+			sec.fireTrigger(trigger)
 		}
 
 		// increment completion counter
@@ -86,6 +76,46 @@ func (sec *sensorExecutionCtx) processTriggers() {
 	sec.log.Info().Msg("triggers can't be executed because event dependencies are not complete")
 }
 
+// fireTrigger executes the trigger unless the execution is suppressed by the rate limit, and records the outcome in the trigger node
+func (sec *sensorExecutionCtx) fireTrigger(trigger v1alpha1.Trigger) {
+	if !sec.allowTrigger(trigger) {
+		sec.suppressTrigger(trigger.Name, "trigger execution is suppressed by rate limit")
+		return
+	}
+
+	// labels for K8s event
+	labels := map[string]string{
+		common.LabelSensorName: sec.sensor.Name,
+		common.LabelOperation:  "process_triggers",
+	}
+
+	if err := sec.executeTrigger(trigger); err != nil {
+		sec.log.Error().Str("trigger-name", trigger.Name).Err(err).Msg("trigger failed to execute")
+
+		sn.MarkNodePhase(sec.sensor, trigger.Name, v1alpha1.NodeTypeTrigger, v1alpha1.NodePhaseError, nil, &sec.log, fmt.Sprintf("failed to execute trigger. err: %+v", err))
+		sec.recordTriggerExecution(trigger.Name, v1alpha1.NodePhaseError, fmt.Sprintf("failed to execute trigger. err: %+v", err))
+		sec.sendTriggerEventsToDeadLetter(trigger.Name, fmt.Sprintf("failed to execute trigger. err: %+v", err))
+
+		// escalate using K8s event
+		labels[common.LabelEventType] = string(common.EscalationEventType)
+		if err := common.GenerateK8sEvent(sec.kubeClient, fmt.Sprintf("failed to execute trigger %s", trigger.Name), common.EscalationEventType,
+			"trigger failure", sec.sensor.Name, sec.sensor.Namespace, sec.controllerInstanceID, sensor.Kind, labels); err != nil {
+			sec.log.Error().Err(err).Msg("failed to create K8s event to escalate trigger failure")
+		}
+		return
+	}
+
+	// mark trigger as complete.
+	sn.MarkNodePhase(sec.sensor, trigger.Name, v1alpha1.NodeTypeTrigger, v1alpha1.NodePhaseComplete, nil, &sec.log, "successfully executed trigger")
+	sec.recordTriggerExecution(trigger.Name, v1alpha1.NodePhaseComplete, "successfully executed trigger")
+
+	labels[common.LabelEventType] = string(common.OperationSuccessEventType)
+	if err := common.GenerateK8sEvent(sec.kubeClient, fmt.Sprintf("trigger %s executed successfully", trigger.Name), common.OperationSuccessEventType,
+		"trigger executed", sec.sensor.Name, sec.sensor.Namespace, sec.controllerInstanceID, sensor.Kind, labels); err != nil {
+		sec.log.Error().Err(err).Msg("failed to create K8s event to log trigger execution")
+	}
+}
+
 // execute the trigger
 func (sec *sensorExecutionCtx) executeTrigger(trigger v1alpha1.Trigger) error {
 	if trigger.Resource != nil {
@@ -101,7 +131,11 @@ func (sec *sensorExecutionCtx) executeTrigger(trigger v1alpha1.Trigger) error {
 		if err != nil {
 			return err
 		}
-		if err = sec.createResourceObject(trigger.Resource, uObj); err != nil {
+		events := sec.extractEvents(trigger.Resource.Parameters)
+		if trigger.FanOut != nil {
+			return sec.fanOutTrigger(trigger, uObj, events)
+		}
+		if err = sec.createResourceObject(trigger.Resource, uObj, events); err != nil {
 			return err
 		}
 	}
@@ -109,7 +143,7 @@ func (sec *sensorExecutionCtx) executeTrigger(trigger v1alpha1.Trigger) error {
 }
 
 // createResourceObject creates K8s object for trigger
-func (sec *sensorExecutionCtx) createResourceObject(resource *v1alpha1.ResourceObject, obj *unstructured.Unstructured) error {
+func (sec *sensorExecutionCtx) createResourceObject(resource *v1alpha1.ResourceObject, obj *unstructured.Unstructured, events map[string]apicommon.Event) error {
 	if resource.Namespace != "" {
 		obj.SetNamespace(resource.Namespace)
 	}
@@ -126,7 +160,7 @@ func (sec *sensorExecutionCtx) createResourceObject(resource *v1alpha1.ResourceO
 
 	// passing parameters to the resource object requires 4 steps
 	// 1. marshaling the obj to JSON
-	// 2. extract the appropriate eventDependency events based on the resource params
+	// 2. extract the appropriate eventDependency events based on the resource params (done by the caller)
 	// 3. apply the params to the JSON object
 	// 4. unmarshal the obj from the updated JSON
 	if len(resource.Parameters) > 0 {
@@ -134,7 +168,6 @@ func (sec *sensorExecutionCtx) createResourceObject(resource *v1alpha1.ResourceO
 		if err != nil {
 			return fmt.Errorf("failed to marshal json. err: %+v", err)
 		}
-		events := sec.extractEvents(resource.Parameters)
 		jUpdatedObj, err := applyParams(jObj, resource.Parameters, events)
 		if err != nil {
 			return fmt.Errorf("failed to apply params. err: %+v", err)
@@ -189,6 +222,31 @@ func (sec *sensorExecutionCtx) extractEvents(params []v1alpha1.ResourceParameter
 				sec.log.Warn().Str("param-src", param.Src.Event).Str("param-dest", param.Dest).Msg("WARNING: event dependency node does not exist, cannot apply parameter")
 				continue
 			}
+			// the batch of a batching event dependency is passed as a JSON array of event payloads
+			if len(node.Events) > 0 {
+				batch, err := mergeBatchEnrichments(node)
+				if err != nil {
+					sec.log.Warn().Err(err).Str("param-src", param.Src.Event).Str("param-dest", param.Dest).Msg("WARNING: failed to merge enrichments of batch of events, cannot apply parameter")
+					continue
+				}
+				batchEvent, err := renderBatchAsEvent(batch)
+				if err != nil {
+					sec.log.Warn().Err(err).Str("param-src", param.Src.Event).Str("param-dest", param.Dest).Msg("WARNING: failed to render batch of events, cannot apply parameter")
+					continue
+				}
+				events[param.Src.Event] = *batchEvent
+				continue
+			}
+			// the results of the enrichments of the event are merged into its data
+			if len(node.Enrichment) > 0 {
+				enriched, err := mergeEnrichment(node.Event, node.Enrichment)
+				if err != nil {
+					sec.log.Warn().Err(err).Str("param-src", param.Src.Event).Str("param-dest", param.Dest).Msg("WARNING: failed to merge enrichment of event, cannot apply parameter")
+					continue
+				}
+				events[param.Src.Event] = *enriched
+				continue
+			}
 			events[param.Src.Event] = *node.Event
 		}
 	}